@@ -4,11 +4,14 @@ import (
 	"fmt"
 	"strconv"
 
-	"github.com/labstack/echo/v4"
+	"github.com/xiriframework/xiri-go/request"
 )
 
 // ExtractMultiSelectRequest parses HTTP requests for multi-select dialog workflows.
 //
+// The request is read through request.Source (request.FromHTTP for net/http,
+// echoadapter.Source for Echo).
+//
 // Dialog Workflow:
 //  1. User selects multiple table rows → POST {"data": [id1, id2, ...]}
 //  2. Server shows dialog with NewDialogFormMultiEdit/Delete → extra["data"] + extra["done"]=true
@@ -19,9 +22,9 @@ import (
 //   - formData: map[string]interface{} - The complete request data (for form binding)
 //   - isDialogOpen: bool - true if step 1 (showing dialog), false if step 3 (form submission)
 //   - error: any parsing or validation error
-func ExtractMultiSelectRequest(c echo.Context) ([]int64, map[string]interface{}, bool, error) {
-	var requestData map[string]interface{}
-	if err := c.Bind(&requestData); err != nil {
+func ExtractMultiSelectRequest(src request.Source) ([]int64, map[string]interface{}, bool, error) {
+	requestData, err := request.Values(src)
+	if err != nil {
 		return nil, nil, false, fmt.Errorf("invalid request data: %w", err)
 	}
 
@@ -14,6 +14,7 @@ type Form struct {
 	buttons    []*button.Button
 	header     *string
 	display    *string
+	rules      []map[string]any
 	hookFields func([]map[string]any)
 	translator core.TranslateFunc
 }
@@ -88,6 +89,18 @@ func (f *Form) WithDisplay(display string) *Form {
 	return f
 }
 
+// WithRules sets the cross-field validation rules evaluated by the frontend
+// (see group.FormGroup.ExportRules). Returns the Form for method chaining
+//
+// Example:
+//
+//	f := form.NewForm(fg.ExportForFrontendWithValues(values), u, nil, nil, nil, translator).
+//	    WithRules(fg.ExportRules())
+func (f *Form) WithRules(rules []map[string]any) *Form {
+	f.rules = rules
+	return f
+}
+
 // Print returns the JSON representation of the form
 // PHP equivalent: XiriForm->print()
 func (f *Form) Print(translator core.TranslateFunc) map[string]any {
@@ -109,14 +122,19 @@ func (f *Form) Print(translator core.TranslateFunc) map[string]any {
 		f.hookFields(fields)
 	}
 
+	data := map[string]any{
+		"header":  f.header,
+		"url":     f.url.PrintPrefix(),
+		"fields":  fields,
+		"buttons": buttonData,
+	}
+	if len(f.rules) > 0 {
+		data["rules"] = f.rules
+	}
+
 	return map[string]any{
 		"type":    "form",
 		"display": f.display,
-		"data": map[string]any{
-			"header":  f.header,
-			"url":     f.url.PrintPrefix(),
-			"fields":  fields,
-			"buttons": buttonData,
-		},
+		"data":    data,
 	}
 }
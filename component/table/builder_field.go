@@ -36,6 +36,24 @@ func (fb *FieldBuilder[T]) WithExcelFormatter(formatter OutputFormatter) *FieldB
 	return fb
 }
 
+// WithJSONLFormatter sets formatter specifically for JSON Lines output (overrides the raw value)
+func (fb *FieldBuilder[T]) WithJSONLFormatter(formatter OutputFormatter) *FieldBuilder[T] {
+	fb.field.formatters[OutputJSONL] = formatter
+	return fb
+}
+
+// WithODSFormatter sets formatter specifically for ODS output (overrides the Excel formatter)
+func (fb *FieldBuilder[T]) WithODSFormatter(formatter OutputFormatter) *FieldBuilder[T] {
+	fb.field.formatters[OutputODS] = formatter
+	return fb
+}
+
+// WithHTMLFormatter sets formatter specifically for HTML output (overrides the PDF formatter)
+func (fb *FieldBuilder[T]) WithHTMLFormatter(formatter OutputFormatter) *FieldBuilder[T] {
+	fb.field.formatters[OutputHTML] = formatter
+	return fb
+}
+
 // WithFooter sets footer aggregation type
 func (fb *FieldBuilder[T]) WithFooter(footer FieldFooter) *FieldBuilder[T] {
 	fb.field.footer = footer
@@ -28,8 +28,10 @@ func createIntegerFormatter() OutputFormatter {
 		switch output {
 		case OutputWeb, OutputPDF:
 			return formatter.FormatNumberLocale(float64(num), 0, ctx.Locale)
-		case OutputCSV, OutputExcel:
+		case OutputCSV:
 			return fmt.Sprint(num)
+		case OutputExcel:
+			return num
 		}
 		return fmt.Sprint(num)
 	})
@@ -41,9 +43,11 @@ func createFloatFormatter(decimals int) OutputFormatter {
 		switch output {
 		case OutputWeb, OutputPDF:
 			return formatter.FormatNumberLocale(num, decimals, ctx.Locale)
-		case OutputCSV, OutputExcel:
+		case OutputCSV:
 			format := "%." + strconv.Itoa(decimals) + "f"
 			return fmt.Sprintf(format, num)
+		case OutputExcel:
+			return roundDecimals(num, decimals)
 		}
 		format := "%." + strconv.Itoa(decimals) + "f"
 		return fmt.Sprintf(format, num)
@@ -385,7 +389,7 @@ func createText2BoolFormatter() OutputFormatter {
 // createTimeLengthFormatter returns a formatter for time duration fields.
 // Expects int64 value in seconds.
 // Web/PDF: "HH:MM" or "Xd HH:MM" format.
-// CSV/Excel: integer minutes.
+// CSV: integer minutes. Excel: integer minutes as number.
 func createTimeLengthFormatter() OutputFormatter {
 	return FormatterFunc(func(value any, row Row, output OutputType, ctx *uicontext.UiContext) any {
 		seconds := toInt64(value)
@@ -393,8 +397,10 @@ func createTimeLengthFormatter() OutputFormatter {
 		switch output {
 		case OutputWeb, OutputPDF:
 			return formatTimeLength(seconds)
-		case OutputCSV, OutputExcel:
+		case OutputCSV:
 			return fmt.Sprintf("%d", seconds/60)
+		case OutputExcel:
+			return seconds / 60
 		}
 		return fmt.Sprintf("%d", seconds/60)
 	})
@@ -809,6 +815,9 @@ func createBoolFormatter(trueText, falseText string) OutputFormatter {
 		if !ok {
 			return ""
 		}
+		if output == OutputExcel {
+			return b
+		}
 		if b {
 			return trueText
 		}
@@ -831,6 +840,9 @@ func createDateTimeFormatter() OutputFormatter {
 				loc = time.UTC
 			}
 			t := time.Unix(timestamp, 0).In(loc)
+			if output == OutputExcel {
+				return excelTime(t)
+			}
 			return t.Format("2006-01-02 15:04:05")
 		}
 		loc, err := time.LoadLocation(ctx.Timezone.GetIANA())
@@ -857,6 +869,9 @@ func createDateFormatter() OutputFormatter {
 				loc = time.UTC
 			}
 			t := time.Unix(timestamp, 0).In(loc)
+			if output == OutputExcel {
+				return excelDate(t)
+			}
 			return t.Format("2006-01-02")
 		}
 		loc, err := time.LoadLocation(ctx.Timezone.GetIANA())
@@ -876,10 +891,12 @@ func createDistanceFormatter(decimals int) OutputFormatter {
 		switch output {
 		case OutputWeb, OutputPDF:
 			return formatter.FormatDistanceLocaleWithDecimals(km, distanceUnit, ctx.Locale, decimals)
-		case OutputCSV, OutputExcel:
+		case OutputCSV:
 			converted := convertDistanceValue(km, distanceUnit)
 			format := "%." + strconv.Itoa(decimals) + "f"
 			return fmt.Sprintf(format, converted)
+		case OutputExcel:
+			return roundDecimals(convertDistanceValue(km, distanceUnit), decimals)
 		}
 		converted := convertDistanceValue(km, distanceUnit)
 		format := "%." + strconv.Itoa(decimals) + "f"
@@ -900,10 +917,12 @@ func createPressureFormatter(decimals int) OutputFormatter {
 				unit = " psi"
 			}
 			return formatter.FormatNumberLocale(converted, decimals, ctx.Locale) + unit
-		case OutputCSV, OutputExcel:
+		case OutputCSV:
 			converted := convertPressureValue(bar, pressureUnit)
 			format := "%." + strconv.Itoa(decimals) + "f"
 			return fmt.Sprintf(format, converted)
+		case OutputExcel:
+			return roundDecimals(convertPressureValue(bar, pressureUnit), decimals)
 		}
 		converted := convertPressureValue(bar, pressureUnit)
 		format := "%." + strconv.Itoa(decimals) + "f"
@@ -927,10 +946,12 @@ func createSpeedFormatter(decimals int) OutputFormatter {
 				unit = " kn"
 			}
 			return formatter.FormatNumberLocale(converted, decimals, ctx.Locale) + unit
-		case OutputCSV, OutputExcel:
+		case OutputCSV:
 			converted := convertSpeedValue(kmh, distanceUnit)
 			format := "%." + strconv.Itoa(decimals) + "f"
 			return fmt.Sprintf(format, converted)
+		case OutputExcel:
+			return roundDecimals(convertSpeedValue(kmh, distanceUnit), decimals)
 		}
 		converted := convertSpeedValue(kmh, distanceUnit)
 		format := "%." + strconv.Itoa(decimals) + "f"
@@ -53,6 +53,12 @@ func (b *TableBuilder[T]) SetExcel(excel bool) *TableBuilder[T] {
 	return b
 }
 
+// SetPdf enables/disables PDF export functionality
+func (b *TableBuilder[T]) SetPdf(pdf bool) *TableBuilder[T] {
+	b.table.options.Pdf = &pdf
+	return b
+}
+
 // SetSaveState enables/disables state saving in local storage
 func (b *TableBuilder[T]) SetSaveState(saveState bool) *TableBuilder[T] {
 	b.table.options.SaveState = &saveState
@@ -99,6 +105,20 @@ func (b *TableBuilder[T]) SetServerSide(serverSide bool) *TableBuilder[T] {
 	return b
 }
 
+// SetServerSideInMemory enables server-side pagination backed by the rows passed to SetData().
+// The table applies _page, _pageSize, _sort, _sortDir and _search itself:
+//   - Search matches the formatted values of visible fields with WithSearch(true)
+//   - Sort uses the raw accessor value of the sort field (numeric, string, bool, time)
+//   - totalCount is set automatically on ToTableDataResponse() / ToServerSideResponse()
+//
+// Intended for small and medium datasets that are loaded completely per request.
+func (b *TableBuilder[T]) SetServerSideInMemory() *TableBuilder[T] {
+	serverSide := true
+	b.table.options.ServerSide = &serverSide
+	b.table.serverSideInMemory = true
+	return b
+}
+
 // ============================================================================
 // Table Option Setters - String Options
 // ============================================================================
@@ -0,0 +1,305 @@
+package table
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xiriframework/xiri-go/component/core"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// ============================================================================
+// Declarative Table Definition via Struct Tags
+// ============================================================================
+
+// structTagName is the struct tag key read by FromStruct.
+const structTagName = "xiri"
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// structFieldTypes maps field type hints to the Go type they expect from the struct field.
+// Scalar hints (Integer, Float, Text, ...) are handled by kind in structAccessor.
+var structFieldTypes = map[FieldTypeHint]reflect.Type{
+	Text2:           reflect.TypeOf([2]string{}),
+	Link:            reflect.TypeOf([2]string{}),
+	Text2Int:        reflect.TypeOf([2]int{}),
+	Text2Float:      reflect.TypeOf([2]float64{}),
+	Text2Distance:   reflect.TypeOf([2]float64{}),
+	Text2Speed:      reflect.TypeOf([2]float64{}),
+	Text2DateTime:   reflect.TypeOf([2]time.Time{}),
+	Text2Date:       reflect.TypeOf([2]time.Time{}),
+	Text2Bool:       reflect.TypeOf([2]bool{}),
+	Text2TimeLength: reflect.TypeOf([2]int64{}),
+	TextN:           reflect.TypeOf([]string{}),
+	IntegerN:        reflect.TypeOf([]int{}),
+	FloatN:          reflect.TypeOf([]float64{}),
+	DistanceN:       reflect.TypeOf([]float64{}),
+	SpeedN:          reflect.TypeOf([]float64{}),
+	DateTimeN:       reflect.TypeOf([]time.Time{}),
+	DateN:           reflect.TypeOf([]time.Time{}),
+	BoolN:           reflect.TypeOf([]bool{}),
+	TimeLengthN:     reflect.TypeOf([]int64{}),
+}
+
+// FromStruct creates a table builder from `xiri` struct tags on T.
+// Only exported fields with a `xiri` tag are added, in declaration order; use `xiri:"-"` to skip.
+// The resulting builder uses the same typed field defaults as the explicit builder methods
+// and accepts further fluent overrides via GetField().
+//
+// Tag options (comma separated key=value pairs, all optional):
+//   - id:       field ID (default: struct field name in lower camel case)
+//   - label:    translation key for the header (default: id)
+//   - type:     FieldTypeHint, e.g. "distance", "datetime", "text2" (default: inferred from Go type)
+//   - decimals: number of decimals for numeric types
+//   - footer:   "sum", "count" or "static"
+//   - csv:      "false" to exclude from CSV/Excel/PDF exports
+//   - hide:     "true" to hide the column
+//   - search:   "true"/"false"
+//   - sort:     "true"/"false"
+//   - align:    "left", "center" or "right"
+//   - width:    column width (e.g. "120px")
+//   - hint:     tooltip text
+//   - prefix:   text prefix
+//   - suffix:   text suffix
+//
+// Buttons and icon fields need additional configuration and are not supported via tags.
+//
+// Example:
+//
+//	type TripRow struct {
+//	    ID       int64     `xiri:"id=id,label=trip.id"`
+//	    Name     string    `xiri:"label=device.name"`
+//	    Distance float64   `xiri:"label=trip.distance,type=distance,decimals=1,footer=sum"`
+//	    Started  time.Time `xiri:"label=trip.started,type=datetime"`
+//	    Internal string    `xiri:"-"`
+//	}
+//
+//	builder, err := table.FromStruct[TripRow](ctx, translator)
+//	if err != nil {
+//	    return err
+//	}
+//	builder.GetField("name").WithWidth("200px")
+//	tbl := builder.Build()
+func FromStruct[T any](ctx *uicontext.UiContext, translator core.TranslateFunc) (*TableBuilder[T], error) {
+	rowType := reflect.TypeFor[T]()
+	if rowType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("table.FromStruct: %s is not a struct", rowType)
+	}
+
+	b := NewBuilder[T](ctx, translator)
+
+	for i := range rowType.NumField() {
+		sf := rowType.Field(i)
+		tag, ok := sf.Tag.Lookup(structTagName)
+		if !ok || tag == "-" || !sf.IsExported() {
+			continue
+		}
+
+		opts, err := parseStructTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("table.FromStruct: field %s: %w", sf.Name, err)
+		}
+
+		id := opts["id"]
+		if id == "" {
+			id = strings.ToLower(sf.Name[:1]) + sf.Name[1:]
+		}
+		label := opts["label"]
+		if label == "" {
+			label = id
+		}
+
+		hint := FieldTypeHint(opts["type"])
+		if hint == "" {
+			hint, ok = inferFieldTypeHint(sf.Type)
+			if !ok {
+				return nil, fmt.Errorf("table.FromStruct: field %s: cannot infer type from %s, set type=...", sf.Name, sf.Type)
+			}
+		}
+
+		accessor, err := structAccessor[T](i, sf.Type, hint)
+		if err != nil {
+			return nil, fmt.Errorf("table.FromStruct: field %s: %w", sf.Name, err)
+		}
+
+		fb := b.fieldInternal(id, label, hint, accessor)
+		if err := applyStructTagOptions(fb, opts); err != nil {
+			return nil, fmt.Errorf("table.FromStruct: field %s: %w", sf.Name, err)
+		}
+	}
+
+	return b, nil
+}
+
+// GetField returns the FieldBuilder for an already added field, for fluent overrides.
+// Returns nil if no field with the given ID exists.
+//
+// Example:
+//
+//	builder.GetField("distance").WithDecimals(3).WithFooterSum()
+func (b *TableBuilder[T]) GetField(id string) *FieldBuilder[T] {
+	for _, field := range b.table.fields {
+		if field.id == id {
+			return &FieldBuilder[T]{field: field}
+		}
+	}
+	return nil
+}
+
+// parseStructTag splits a `xiri` tag into key/value options.
+func parseStructTag(tag string) (map[string]string, error) {
+	opts := make(map[string]string)
+	for part := range strings.SplitSeq(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid tag option %q (expected key=value)", part)
+		}
+		opts[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return opts, nil
+}
+
+// inferFieldTypeHint derives the field type hint from the Go type of a struct field.
+func inferFieldTypeHint(t reflect.Type) (FieldTypeHint, bool) {
+	if t == timeType {
+		return DateTime, true
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Integer, true
+	case reflect.Float32, reflect.Float64:
+		return Float, true
+	case reflect.String:
+		return Text, true
+	case reflect.Bool:
+		return Bool, true
+	}
+
+	// Multi-line types: first matching hint in a fixed preference order
+	for _, hint := range []FieldTypeHint{Text2, Text2Int, Text2Float, Text2DateTime, Text2Bool, Text2TimeLength,
+		TextN, IntegerN, FloatN, DateTimeN, BoolN, TimeLengthN} {
+		if structFieldTypes[hint] == t {
+			return hint, true
+		}
+	}
+	return "", false
+}
+
+// structAccessor creates an accessor for struct field i that returns the value
+// in the representation expected by the formatter of the given hint.
+func structAccessor[T any](i int, t reflect.Type, hint FieldTypeHint) (func(T) any, error) {
+	field := func(row T) reflect.Value {
+		return reflect.ValueOf(row).Field(i)
+	}
+
+	isInt := t.Kind() >= reflect.Int && t.Kind() <= reflect.Int64
+	isFloat := t.Kind() == reflect.Float32 || t.Kind() == reflect.Float64
+
+	switch hint {
+	case Id, Integer, TimeLength:
+		if isInt {
+			return func(row T) any { return field(row).Int() }, nil
+		}
+	case Float, Distance, Pressure, Speed:
+		if isFloat {
+			return func(row T) any { return field(row).Float() }, nil
+		}
+		if isInt {
+			return func(row T) any { return float64(field(row).Int()) }, nil
+		}
+	case Text, Html, Header:
+		if t.Kind() == reflect.String {
+			return func(row T) any { return field(row).String() }, nil
+		}
+	case Bool:
+		if t.Kind() == reflect.Bool {
+			return func(row T) any { return field(row).Bool() }, nil
+		}
+	case DateTime, Date:
+		// Converted to Unix seconds like DateTimeField/DateField
+		if t == timeType {
+			return func(row T) any { return field(row).Interface().(time.Time).Unix() }, nil
+		}
+		if isInt {
+			return func(row T) any { return field(row).Int() }, nil
+		}
+	case Input:
+		return func(row T) any { return field(row).Interface() }, nil
+	case Buttons, Icon:
+		return nil, fmt.Errorf("type %s is not supported in struct tags, use the builder method", hint)
+	default:
+		expected, ok := structFieldTypes[hint]
+		if !ok {
+			return nil, fmt.Errorf("unknown type %q", hint)
+		}
+		if t == expected {
+			return func(row T) any { return field(row).Interface() }, nil
+		}
+		return nil, fmt.Errorf("type %s expects %s, got %s", hint, expected, t)
+	}
+
+	return nil, fmt.Errorf("type %s is not compatible with %s", hint, t)
+}
+
+// applyStructTagOptions applies the remaining tag options to the field builder.
+func applyStructTagOptions[T any](fb *FieldBuilder[T], opts map[string]string) error {
+	for key, value := range opts {
+		switch key {
+		case "id", "label", "type":
+			// Handled by FromStruct
+		case "decimals":
+			decimals, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid decimals %q", value)
+			}
+			fb.WithDecimals(decimals)
+		case "footer":
+			switch FieldFooter(value) {
+			case FieldFooterNo, FieldFooterSum, FieldFooterCount, FieldFooterStatic:
+				fb.WithFooter(FieldFooter(value))
+			default:
+				return fmt.Errorf("invalid footer %q", value)
+			}
+		case "csv", "hide", "search", "sort":
+			enabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid %s %q", key, value)
+			}
+			switch key {
+			case "csv":
+				fb.field.csv = enabled
+			case "hide":
+				fb.field.hide = enabled
+			case "search":
+				fb.WithSearch(enabled)
+			case "sort":
+				fb.WithSort(enabled)
+			}
+		case "align":
+			switch FieldAlign(value) {
+			case FieldAlignLeft, FieldAlignCenter, FieldAlignRight:
+				fb.WithAlign(FieldAlign(value))
+			default:
+				return fmt.Errorf("invalid align %q", value)
+			}
+		case "width":
+			fb.WithWidth(value)
+		case "hint":
+			fb.WithHint(value)
+		case "prefix":
+			fb.WithTextPrefix(value)
+		case "suffix":
+			fb.WithTextSuffix(value)
+		default:
+			return fmt.Errorf("unknown tag option %q", key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+// Test row struct with xiri tags
+type testStructRow struct {
+	ID       int64     `xiri:"id=id,label=device.id,type=id"`
+	Name     string    `xiri:"label=device.name,width=200px"`
+	Distance float64   `xiri:"id=distance,label=trip.distance,type=distance,decimals=1,footer=sum"`
+	Started  time.Time `xiri:"label=trip.started,type=date,csv=false"`
+	Lines    []string  `xiri:"label=device.lines"`
+	Active   bool      `xiri:"label=device.active,hide=true"`
+	Internal string    `xiri:"-"`
+	Untagged string
+}
+
+// TestFromStruct verifies fields are created from struct tags with typed defaults
+func TestFromStruct(t *testing.T) {
+	builder, err := FromStruct[testStructRow](testContext(), testTranslator)
+	if err != nil {
+		t.Fatalf("FromStruct failed: %v", err)
+	}
+	tbl := builder.Build()
+
+	fields := tbl.GetFields()
+	ids := make([]string, len(fields))
+	for i, f := range fields {
+		ids[i] = f.GetID()
+	}
+	expected := []string{"id", "name", "distance", "started", "lines", "active"}
+	if len(ids) != len(expected) {
+		t.Fatalf("Expected fields %v, got %v", expected, ids)
+	}
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Fatalf("Expected fields %v, got %v", expected, ids)
+		}
+	}
+
+	distance := fields[2]
+	if distance.GetFieldTypeHint() != Distance || distance.decimals != 1 || distance.GetFooter() != FieldFooterSum {
+		t.Errorf("Unexpected distance config: hint=%s decimals=%d footer=%s",
+			distance.GetFieldTypeHint(), distance.decimals, distance.GetFooter())
+	}
+	if fields[0].IsCsvEnabled() {
+		t.Error("Expected id type to default to csv=false")
+	}
+	if fields[3].IsCsvEnabled() {
+		t.Error("Expected csv=false on started")
+	}
+	if fields[4].GetFieldTypeHint() != TextN {
+		t.Errorf("Expected []string to infer TextN, got %s", fields[4].GetFieldTypeHint())
+	}
+	if !fields[5].IsHidden() {
+		t.Error("Expected active to be hidden")
+	}
+	if w := fields[1].GetWidth(); w == nil || *w != "200px" {
+		t.Errorf("Expected width 200px, got %v", w)
+	}
+
+	tbl.SetData([]testStructRow{{ID: 7, Name: "Truck", Distance: 12.34, Started: time.Unix(1700000000, 0)}})
+	row := tbl.GetData(OutputCSV)[0]
+	if row["distance"] != "12.3" {
+		t.Errorf("Expected distance formatted with 1 decimal, got %v", row["distance"])
+	}
+	if row["name"] != "Truck" {
+		t.Errorf("Expected name Truck, got %v", row["name"])
+	}
+}
+
+// TestFromStructOverrides verifies fluent overrides on tag-defined fields
+func TestFromStructOverrides(t *testing.T) {
+	builder, err := FromStruct[testStructRow](testContext(), testTranslator)
+	if err != nil {
+		t.Fatalf("FromStruct failed: %v", err)
+	}
+	builder.GetField("distance").WithDecimals(3).WithFooterCount()
+	if builder.GetField("missing") != nil {
+		t.Error("Expected nil for unknown field")
+	}
+
+	f := builder.Build().GetFields()[2]
+	if f.decimals != 3 || f.GetFooter() != FieldFooterCount {
+		t.Errorf("Expected overrides to apply, got decimals=%d footer=%s", f.decimals, f.GetFooter())
+	}
+}
+
+// TestFromStructErrors verifies invalid tags are reported
+func TestFromStructErrors(t *testing.T) {
+	type badType struct {
+		Name string `xiri:"type=distance"`
+	}
+	if _, err := FromStruct[badType](testContext(), testTranslator); err == nil {
+		t.Error("Expected error for incompatible type")
+	}
+
+	type badOption struct {
+		Name string `xiri:"color=red"`
+	}
+	if _, err := FromStruct[badOption](testContext(), testTranslator); err == nil {
+		t.Error("Expected error for unknown option")
+	}
+
+	if _, err := FromStruct[int](testContext(), testTranslator); err == nil {
+		t.Error("Expected error for non-struct type")
+	}
+}
@@ -1703,7 +1703,7 @@ func TestIntegration05_MultiFormatOutput(t *testing.T) {
 	// - OutputWeb: Arrays for sortable numbers, includes all fields
 	// - OutputCSV: Plain text values, respects WithCsv(false)
 	// - OutputPDF: Same as CSV
-	// - OutputExcel: Same as CSV
+	// - OutputExcel: Native numbers, dates and booleans with number formats
 	// - Use same table definition for all formats
 }
 
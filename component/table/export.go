@@ -1,6 +1,8 @@
 package table
 
 import (
+	"time"
+
 	"github.com/xiriframework/xiri-go/component/core"
 	"github.com/xiriframework/xiri-go/response"
 )
@@ -17,15 +19,30 @@ import (
 //	devices, totalCount := dbm.Device.FindWithPagination(filters, page, pageSize)
 //	tbl.SetData(devices)
 //	return wc.TableData(tbl.ToServerSideResponse(totalCount))
+//
+// In in-memory server-side mode (SetServerSideInMemory) the totalCount is computed
+// automatically from the searched rows and the parameter is ignored.
 func (t *Table[T]) ToServerSideResponse(totalCount int) *TableDataResponse {
 	response := t.ToTableDataResponse()
-	response.WithTotalCount(totalCount)
+	if !t.serverSideInMemory {
+		response.WithTotalCount(totalCount)
+	}
 	return response
 }
 
 // DataResponse returns a DataResult by delegating to ToTableDataResponse().DataResponse().
+// Exports (CSV, Excel, PDF, ...) are named after the table title and the current time in the
+// user's timezone (see response.ExportFilename and response.Write).
 func (t *Table[T]) DataResponse(translator core.TranslateFunc) response.DataResult {
-	return t.ToTableDataResponse().DataResponse(translator)
+	result := t.ToTableDataResponse().DataResponse(translator)
+	if result.Type != response.ResponseJSON {
+		title := ""
+		if t.options.Title != nil {
+			title = *t.options.Title
+		}
+		result.Filename = response.ExportFilename(t.ctx, title, time.Now())
+	}
+	return result
 }
 
 // ToTableDataResponse creates an AJAX data response with exact JSON structure.
@@ -37,16 +54,40 @@ func (t *Table[T]) DataResponse(translator core.TranslateFunc) response.DataResu
 //
 // This method uses the table's internal outputType (set via SetOutputType).
 // For CSV export, set outputType to OutputCSV before calling this method.
+// For a PDF report, set outputType to OutputPDF; the table title and footer are included.
+// OutputJSONL, OutputODS and OutputHTML produce JSON Lines, OpenDocument spreadsheets and
+// HTML reports (title and footer included).
 //
 // This method is specifically for AJAX endpoint handlers that return table data
 // without the full component definition. Use Print() to get the full component JSON.
 func (t *Table[T]) ToTableDataResponse() *TableDataResponse {
+	return t.toTableDataResponse(t.outputType)
+}
+
+// toTableDataResponse creates the data response for the given output type
+// (used by ToTableDataResponse and by Workbook for Excel sheets).
+func (t *Table[T]) toTableDataResponse(output OutputType) *TableDataResponse {
+	// In in-memory server-side mode, apply search/sort/paging to the rows.
+	// Exports (CSV, Excel, PDF, ...) contain all matching rows, the web output only the requested page.
+	rows := t.data
+	footerRows := t.data
+	if t.serverSideInMemory {
+		matched, page := t.applyServerSide(t.LoadPaginationParams())
+		footerRows = matched
+		rows = matched
+		if output == OutputWeb {
+			rows = page
+		}
+	}
 
 	// Get formatted data with all formatters applied using internal outputType
-	data := t.GetData(t.outputType)
+	data := t.getData(rows, output)
 
 	// Create response with outputType
-	response := NewTableDataResponse(data, t.outputType)
+	response := NewTableDataResponse(data, output)
+	if t.serverSideInMemory && output == OutputWeb {
+		response.WithTotalCount(len(footerRows))
+	}
 
 	// Add field definitions for CSV header generation (internal only, not in JSON output)
 	// Export fields with translator (use empty translator if not set)
@@ -56,12 +97,32 @@ func (t *Table[T]) ToTableDataResponse() *TableDataResponse {
 	}
 
 	// For CSV output, filter out fields with csv=false
-	if t.outputType == OutputCSV {
+	if output == OutputCSV {
+		fields := t.exportFieldsForCSV(trans)
+		response.withFieldsForCSV(fields)
+		response.WithCSVOptions(t.csvOptions.resolve(t.ctx))
+	} else if output == OutputExcel {
+		fields := t.exportFieldsForCSV(trans)
+		response.withFieldsForCSV(fields)
+		response.withExcelFormats(t.excelFormats())
+	} else if output == OutputPDF {
+		// PDF reports use the same column selection as CSV/Excel exports
+		fields := t.exportFieldsForCSV(trans)
+		response.withFieldsForCSV(fields)
+		response.withTitle(t.options.Title)
+	} else if output == OutputJSONL {
+		fields := t.exportFieldsForCSV(trans)
+		response.withFieldsForCSV(fields)
+	} else if output == OutputODS {
 		fields := t.exportFieldsForCSV(trans)
 		response.withFieldsForCSV(fields)
-	} else if t.outputType == OutputExcel {
+		response.withExcelFormats(t.excelFormats())
+		response.withTitle(t.options.Title)
+	} else if output == OutputHTML {
+		// HTML reports use the same column selection and layout as PDF reports
 		fields := t.exportFieldsForCSV(trans)
 		response.withFieldsForCSV(fields)
+		response.withTitle(t.options.Title)
 	} else if t.fieldsCanChange {
 		fields := t.exportFields(trans)
 		response.WithFields(fields)
@@ -70,9 +131,10 @@ func (t *Table[T]) ToTableDataResponse() *TableDataResponse {
 
 	// Add components (only for Web output, excluded from CSV/PDF/Excel)
 	// Components like MultiProgress, Charts, Info messages are rendered alongside the table
-	if t.outputType != OutputCSV {
+	// JSON Lines contain the rows only (no footer)
+	if output != OutputCSV && output != OutputJSONL {
 		// Calculate and add footer if any fields have aggregations
-		footer := t.CalculateFooter(t.outputType)
+		footer := t.calculateFooter(footerRows, output)
 		if len(footer) > 0 {
 			response.WithFooter(footer)
 		}
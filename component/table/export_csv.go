@@ -0,0 +1,172 @@
+package table
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/xiriframework/xiri-go/formatter"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// ============================================================================
+// CSV Dialect
+// ============================================================================
+//
+// CSV exports are written in the dialect of CSVOptions: delimiter (by default
+// derived from the user's locale), optional UTF-8 BOM, line endings and the
+// value mode. A tab delimiter produces a TSV file (response.ResponseTSV).
+//
+// The options are set per table (SetCSVOptions) and can be selected per request
+// alongside _csv (see LoadFilterData):
+//
+//	_tsv: true                  TSV export (tab delimiter)
+//	_csvDelimiter: ";"          delimiter: ",", ";", "|" or "tab"
+//	_csvBom: true               UTF-8 BOM
+//	_csvCrlf: true              \r\n line endings
+//	_csvMode: "human"           value mode: "machine" or "human"
+
+// CSVMode selects how values are written in CSV exports.
+type CSVMode int
+
+const (
+	// CSVMachine writes values for further processing: numbers with "." and without
+	// thousands separators or units, dates as ISO 8601 ("2006-01-02", "2006-01-02 15:04:05")
+	// in the user's timezone. These are the values of the CSV formatters (default).
+	CSVMachine CSVMode = iota
+
+	// CSVHuman writes values as shown in the web table: locale-formatted numbers with
+	// units and localized dates. Fields with a CSV-specific formatter (WithCSVFormatter)
+	// keep it.
+	CSVHuman
+)
+
+// String returns the request value of the CSV mode ("machine" or "human")
+func (m CSVMode) String() string {
+	if m == CSVHuman {
+		return "human"
+	}
+	return "machine"
+}
+
+// CSVDelimiterAuto selects the delimiter from the user's locale: ";" for locales with
+// a decimal comma (Excel in Europe expects it), "," otherwise.
+const CSVDelimiterAuto rune = 0
+
+// CSVOptions configures the dialect of CSV exports.
+type CSVOptions struct {
+	Delimiter rune    // Field delimiter (CSVDelimiterAuto = from locale, '\t' = TSV)
+	BOM       bool    // Prefix the file with a UTF-8 byte order mark (response.Write does not add one otherwise)
+	CRLF      bool    // Use \r\n line endings instead of \n
+	Mode      CSVMode // Machine- or human-readable values
+}
+
+// IsTSV returns true if the options produce a tab-separated file.
+func (o CSVOptions) IsTSV() bool {
+	return o.Delimiter == '\t'
+}
+
+// resolve returns the options with the delimiter resolved for the user's locale.
+func (o CSVOptions) resolve(ctx *uicontext.UiContext) CSVOptions {
+	if o.Delimiter == CSVDelimiterAuto {
+		o.Delimiter = ','
+		if ctx == nil || formatter.UsesCommaDecimal(ctx.Locale) {
+			o.Delimiter = ';'
+		}
+	}
+	return o
+}
+
+// newCSVWriter returns a CSV writer for the dialect and writes the BOM if enabled.
+// The delimiter must be resolved (see resolve).
+func newCSVWriter(w io.Writer, opts CSVOptions) *csv.Writer {
+	if opts.BOM {
+		io.WriteString(w, "\ufeff")
+	}
+	writer := csv.NewWriter(w)
+	writer.Comma = opts.Delimiter
+	writer.UseCRLF = opts.CRLF
+	return writer
+}
+
+// csvRequestParams are the request parameters of the CSV dialect (see LoadFilterData).
+var csvRequestParams = []string{"_tsv", "_csvDelimiter", "_csvBom", "_csvCrlf", "_csvMode"}
+
+// applyCSVRequest applies the CSV dialect parameters of a table data request to the options.
+func (o CSVOptions) applyCSVRequest(requestData map[string]interface{}) (CSVOptions, error) {
+	if raw, ok := requestData["_csvDelimiter"]; ok {
+		switch raw {
+		case ",", ";", "|":
+			o.Delimiter = rune(raw.(string)[0])
+		case "tab", "\t":
+			o.Delimiter = '\t'
+		case "auto", "":
+			o.Delimiter = CSVDelimiterAuto
+		default:
+			return o, fmt.Errorf("invalid CSV delimiter %v", raw)
+		}
+	}
+	if requestFlag(requestData["_tsv"]) {
+		o.Delimiter = '\t'
+	}
+	if raw, ok := requestData["_csvBom"]; ok {
+		o.BOM = requestFlag(raw)
+	}
+	if raw, ok := requestData["_csvCrlf"]; ok {
+		o.CRLF = requestFlag(raw)
+	}
+	if raw, ok := requestData["_csvMode"]; ok {
+		switch raw {
+		case "machine":
+			o.Mode = CSVMachine
+		case "human":
+			o.Mode = CSVHuman
+		default:
+			return o, fmt.Errorf("invalid CSV mode %v", raw)
+		}
+	}
+	return o, nil
+}
+
+// requestFlag returns true for a boolean request flag sent as true or "true".
+func requestFlag(value any) bool {
+	switch v := value.(type) {
+	case bool:
+		return v
+	case string:
+		return v == "true"
+	}
+	return false
+}
+
+// SetCSVOptions sets the dialect of CSV exports (delimiter, BOM, line endings, value mode).
+//
+// Example:
+//
+//	tbl.SetCSVOptions(table.CSVOptions{Delimiter: ',', BOM: true, Mode: table.CSVHuman})
+func (t *Table[T]) SetCSVOptions(opts CSVOptions) *Table[T] {
+	t.csvOptions = opts
+	return t
+}
+
+// GetCSVOptions returns the dialect of CSV exports (including request parameters
+// applied by LoadFilterData).
+func (t *Table[T]) GetCSVOptions() CSVOptions {
+	return t.csvOptions
+}
+
+// formatCSV formats a value for CSV output in the given mode. Human-readable values use
+// the PDF formatter (as displayed, without web-only markup) unless the field has a
+// CSV-specific formatter; two-line values are joined with " - ".
+func (f *Field[T]) formatCSV(value any, row Row, mode CSVMode, ctx *uicontext.UiContext) any {
+	if mode == CSVHuman {
+		if _, ok := f.formatters[OutputCSV]; !ok {
+			formatted := f.Format(value, row, OutputPDF, ctx)
+			if pair, ok := formatted.([2]string); ok {
+				return streamCellValue(pair)
+			}
+			return formatted
+		}
+	}
+	return f.Format(value, row, OutputCSV, ctx)
+}
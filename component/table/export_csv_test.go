@@ -0,0 +1,131 @@
+package table
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/xiriframework/xiri-go/request"
+	"github.com/xiriframework/xiri-go/response"
+	"github.com/xiriframework/xiri-go/types/locale"
+)
+
+type testCSVRow struct {
+	Name     string
+	Distance float64
+}
+
+func buildTestCSVTable(loc locale.Locale) *Table[testCSVRow] {
+	ctx := testContext()
+	ctx.Locale = loc
+	builder := NewBuilder[testCSVRow](ctx, testTranslator)
+	builder.TextField("name", "device.name", func(r testCSVRow) string { return r.Name })
+	builder.DistanceField("distance", "trip.distance", func(r testCSVRow) float64 { return r.Distance })
+	tbl := builder.Build()
+	tbl.SetData([]testCSVRow{{Name: "Truck, large", Distance: 1234.5}})
+	tbl.SetOutputType(OutputCSV)
+	return tbl
+}
+
+func csvBody(t *testing.T, result response.DataResult) string {
+	t.Helper()
+	body, ok := result.Body.(string)
+	if !ok {
+		t.Fatalf("Expected string body, got %T", result.Body)
+	}
+	return body
+}
+
+// TestCSVDelimiterFromLocale verifies the automatic delimiter and machine-readable numbers
+func TestCSVDelimiterFromLocale(t *testing.T) {
+	body := csvBody(t, buildTestCSVTable(locale.De).DataResponse(testTranslator))
+	if !strings.Contains(body, "Truck, large;1234.50\n") {
+		t.Errorf("Expected semicolon delimiter for German locale, got %q", body)
+	}
+
+	body = csvBody(t, buildTestCSVTable(locale.EnUS).DataResponse(testTranslator))
+	if !strings.Contains(body, "\"Truck, large\",1234.50\n") {
+		t.Errorf("Expected comma delimiter for US locale, got %q", body)
+	}
+}
+
+// TestCSVOptions verifies BOM, CRLF, human mode and TSV output
+func TestCSVOptions(t *testing.T) {
+	tbl := buildTestCSVTable(locale.De)
+	tbl.SetCSVOptions(CSVOptions{BOM: true, CRLF: true, Mode: CSVHuman})
+
+	result := tbl.DataResponse(testTranslator)
+	body := csvBody(t, result)
+	if !strings.HasPrefix(body, "\ufeffDevice Name;") {
+		t.Errorf("Expected BOM before header, got %q", body)
+	}
+	if !strings.HasSuffix(body, "Truck, large;1.234,50 km\r\n") {
+		t.Errorf("Expected human-readable value with CRLF, got %q", body)
+	}
+	if result.Type != response.ResponseCSV {
+		t.Errorf("Expected ResponseCSV, got %v", result.Type)
+	}
+
+	tbl.SetCSVOptions(CSVOptions{Delimiter: '\t'})
+	result = tbl.DataResponse(testTranslator)
+	if result.Type != response.ResponseTSV || !strings.Contains(csvBody(t, result), "Truck, large\t1234.50\n") {
+		t.Errorf("Expected TSV output, got %v %q", result.Type, result.Body)
+	}
+
+	// response.Write keeps the table's choice: no BOM without CSVOptions.BOM
+	rec := httptest.NewRecorder()
+	if err := response.Write(rec, nil, result, response.WriteOptions{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.HasPrefix(rec.Body.String(), "\ufeff") {
+		t.Errorf("Expected TSV without BOM, got %q", rec.Body.String())
+	}
+}
+
+// TestCSVOptionsFromRequest verifies the CSV dialect request parameters
+func TestCSVOptionsFromRequest(t *testing.T) {
+	tbl := buildTestCSVTable(locale.De)
+	tbl.SetOutputType(OutputWeb)
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(
+		`{"_tsv": true, "_csvBom": "true", "_csvMode": "human", "name": "x"}`))
+	req.Header.Set("Content-Type", "application/json")
+	filters, err := tbl.LoadFilterData(request.FromHTTP(req))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if tbl.GetOutputType() != OutputCSV {
+		t.Errorf("Expected OutputCSV for _tsv, got %v", tbl.GetOutputType())
+	}
+	opts := tbl.GetCSVOptions()
+	if !opts.IsTSV() || !opts.BOM || opts.Mode != CSVHuman {
+		t.Errorf("Unexpected CSV options %+v", opts)
+	}
+	if _, ok := filters["_csvMode"]; ok || filters["name"] != "x" {
+		t.Errorf("Expected CSV parameters removed from filters, got %v", filters)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"_csv": true, "_csvDelimiter": "#"}`))
+	req.Header.Set("Content-Type", "application/json")
+	if _, err := tbl.LoadFilterData(request.FromHTTP(req)); err == nil {
+		t.Error("Expected error for invalid delimiter")
+	}
+}
+
+// TestWriteCSVDialect verifies the streaming export uses the CSV options
+func TestWriteCSVDialect(t *testing.T) {
+	tbl := buildTestCSVTable(locale.EnUS)
+	tbl.SetCSVOptions(CSVOptions{Delimiter: '|'})
+
+	var buf bytes.Buffer
+	if err := tbl.WriteCSV(&buf, slices.Values([]testCSVRow{{Name: "Van", Distance: 2}})); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if buf.String() != "Device Name|trip.distance\nVan|2.00\n" {
+		t.Errorf("Unexpected CSV %q", buf.String())
+	}
+}
@@ -0,0 +1,297 @@
+package table
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// ============================================================================
+// Excel Cells and Sheet Layout
+// ============================================================================
+//
+// Excel exports write native cells instead of text: the Excel formatters return
+// numbers (int64, float64 rounded to the field's decimals), dates (time.Time in
+// the user's timezone) and booleans. Each column gets a number format derived
+// from the field type and decimals (see excelNumberFormat), so users can sum,
+// sort and filter the values in Excel.
+//
+// Every sheet has a bold header row that stays frozen while scrolling, an
+// auto-filter over the data, auto-sized columns and a footer row with SUM
+// (WithFooterSum) or COUNTA (WithFooterCount) formulas.
+//
+// Multi-line fields (Text2*, TextN, ...) are written as text.
+
+// excelTime returns the wall-clock time of t as UTC time. Excel cells have no
+// timezone and excelize converts the absolute instant, so the user's local time
+// must be passed as UTC.
+func excelTime(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+}
+
+// excelDate returns the date of t (midnight, without time of day) as UTC time.
+func excelDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// roundDecimals rounds v to the given number of decimals.
+func roundDecimals(v float64, decimals int) float64 {
+	p := math.Pow10(decimals)
+	return math.Round(v*p) / p
+}
+
+// excelDecimalFormat returns the Excel number format with thousands separator
+// for the given number of decimals ("#,##0.00" for 2). Excel displays the
+// separators of the user's locale.
+func excelDecimalFormat(decimals int) string {
+	if decimals <= 0 {
+		return "#,##0"
+	}
+	return "#,##0." + strings.Repeat("0", decimals)
+}
+
+// excelNumberFormat returns the Excel number format of the field's cells
+// ("" = General, e.g. for text fields).
+func (f *Field[T]) excelNumberFormat() string {
+	switch f.fieldTypeHint {
+	case Id:
+		return "0"
+	case Integer, TimeLength:
+		return "#,##0"
+	case Float, Distance, Pressure, Speed:
+		return excelDecimalFormat(f.decimals)
+	case DateTime:
+		return "yyyy-mm-dd hh:mm:ss"
+	case Date:
+		return "yyyy-mm-dd"
+	}
+	return ""
+}
+
+// excelFormat is the number format and footer aggregation of an exported column.
+type excelFormat struct {
+	numFmt string
+	footer FieldFooter
+}
+
+// excelFormats returns the Excel formats of the table's fields by field ID.
+func (t *Table[T]) excelFormats() map[string]excelFormat {
+	formats := make(map[string]excelFormat, len(t.fields))
+	for _, field := range t.fields {
+		formats[field.GetID()] = excelFormat{numFmt: field.excelNumberFormat(), footer: field.GetFooter()}
+	}
+	return formats
+}
+
+// excelColumn is a column of an Excel sheet.
+type excelColumn struct {
+	name string // Translated header
+	excelFormat
+}
+
+// excelSheet is the content of one Excel worksheet.
+type excelSheet struct {
+	columns []excelColumn
+	rows    [][]any // Cell values in column order (nil = empty cell)
+}
+
+// excelStyles creates and caches the cell styles of a workbook.
+type excelStyles struct {
+	f   *excelize.File
+	ids map[string]int
+}
+
+func newExcelStyles(f *excelize.File) *excelStyles {
+	return &excelStyles{f: f, ids: make(map[string]int)}
+}
+
+// get returns the style with the number format (may be "") and optionally bold font.
+func (s *excelStyles) get(numFmt string, bold bool) (int, error) {
+	key := numFmt + "|" + strconv.FormatBool(bold)
+	if id, ok := s.ids[key]; ok {
+		return id, nil
+	}
+	style := &excelize.Style{}
+	if numFmt != "" {
+		style.CustomNumFmt = &numFmt
+	}
+	if bold {
+		style.Font = &excelize.Font{Bold: true}
+	}
+	id, err := s.f.NewStyle(style)
+	if err != nil {
+		return 0, fmt.Errorf("error creating Excel style: %w", err)
+	}
+	s.ids[key] = id
+	return id, nil
+}
+
+// excelFooterFormula returns the footer formula of a column over the data rows
+// (rows 2 to lastRow), or "" if the column has no footer aggregation.
+func excelFooterFormula(col string, footer FieldFooter, lastRow int) string {
+	switch footer {
+	case FieldFooterSum:
+		return fmt.Sprintf("SUM(%s2:%s%d)", col, col, lastRow)
+	case FieldFooterCount:
+		return fmt.Sprintf("COUNTA(%s2:%s%d)", col, col, lastRow)
+	}
+	return ""
+}
+
+// excelFooterFormat returns the number format of a footer cell.
+func excelFooterFormat(format excelFormat) string {
+	if format.footer == FieldFooterCount {
+		return "#,##0"
+	}
+	return format.numFmt
+}
+
+// calcOnLoad makes Excel calculate the formulas when opening the workbook
+// (formulas are written without cached values).
+func calcOnLoad(f *excelize.File) error {
+	fullCalcOnLoad := true
+	if err := f.SetCalcProps(&excelize.CalcPropsOptions{FullCalcOnLoad: &fullCalcOnLoad}); err != nil {
+		return fmt.Errorf("error setting Excel calculation properties: %w", err)
+	}
+	return nil
+}
+
+// excelHeaderPanes freezes the header row.
+var excelHeaderPanes = excelize.Panes{Freeze: true, YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft"}
+
+// excelColumnWidth returns the column width for the longest content
+// (1.2 per character, between 10 and 50).
+func excelColumnWidth(length int) float64 {
+	return min(max(float64(length)*1.2, 10), 50)
+}
+
+// excelValueLength estimates the number of characters Excel displays for a value.
+func excelValueLength(value any, numFmt string) int {
+	switch v := value.(type) {
+	case string:
+		return utf8.RuneCountInString(v)
+	case time.Time:
+		return len(numFmt)
+	}
+	// Numbers: digits plus thousands separators
+	n := len(fmt.Sprint(value))
+	return n + n/3
+}
+
+// writeExcelSheet writes the header, rows and footer of a sheet to an existing
+// worksheet and applies the layout (number formats, frozen header, auto-filter,
+// column widths). A sheet without columns stays empty.
+func writeExcelSheet(f *excelize.File, sheetName string, sheet excelSheet) error {
+	if len(sheet.columns) == 0 {
+		return nil
+	}
+	styles := newExcelStyles(f)
+	lastCol, err := excelize.ColumnNumberToName(len(sheet.columns))
+	if err != nil {
+		return fmt.Errorf("error getting Excel column name: %w", err)
+	}
+	lastRow := len(sheet.rows) + 1 // Excel rows are 1-indexed, +1 for header
+
+	// Header row (bold)
+	lengths := make([]int, len(sheet.columns))
+	for colIdx, col := range sheet.columns {
+		cellName, err := excelize.CoordinatesToCellName(colIdx+1, 1)
+		if err != nil {
+			return fmt.Errorf("error getting cell name for header: %w", err)
+		}
+		if err := f.SetCellValue(sheetName, cellName, col.name); err != nil {
+			return fmt.Errorf("error writing header cell: %w", err)
+		}
+		lengths[colIdx] = utf8.RuneCountInString(col.name)
+	}
+	headerStyle, err := styles.get("", true)
+	if err != nil {
+		return err
+	}
+	if err := f.SetCellStyle(sheetName, "A1", lastCol+"1", headerStyle); err != nil {
+		return fmt.Errorf("error setting Excel header style: %w", err)
+	}
+
+	// Data rows
+	for rowIdx, row := range sheet.rows {
+		for colIdx, value := range row {
+			if value == nil {
+				continue // Leave cell empty
+			}
+			cellName, err := excelize.CoordinatesToCellName(colIdx+1, rowIdx+2)
+			if err != nil {
+				return fmt.Errorf("error getting cell name for data: %w", err)
+			}
+			if err := f.SetCellValue(sheetName, cellName, value); err != nil {
+				return fmt.Errorf("error writing data cell: %w", err)
+			}
+			lengths[colIdx] = max(lengths[colIdx], excelValueLength(value, sheet.columns[colIdx].numFmt))
+		}
+	}
+
+	hasFooter := false
+	for colIdx, col := range sheet.columns {
+		colName, err := excelize.ColumnNumberToName(colIdx + 1)
+		if err != nil {
+			return fmt.Errorf("error getting Excel column name: %w", err)
+		}
+
+		// Number format of the data cells
+		if col.numFmt != "" && len(sheet.rows) > 0 {
+			style, err := styles.get(col.numFmt, false)
+			if err != nil {
+				return err
+			}
+			if err := f.SetCellStyle(sheetName, colName+"2", colName+strconv.Itoa(lastRow), style); err != nil {
+				return fmt.Errorf("error setting Excel number format: %w", err)
+			}
+		}
+
+		// Footer formula below the data
+		formula := excelFooterFormula(colName, col.footer, lastRow)
+		if formula == "" || len(sheet.rows) == 0 {
+			continue
+		}
+		footerCell := colName + strconv.Itoa(lastRow+1)
+		if err := f.SetCellFormula(sheetName, footerCell, formula); err != nil {
+			return fmt.Errorf("error writing footer formula: %w", err)
+		}
+		style, err := styles.get(excelFooterFormat(col.excelFormat), true)
+		if err != nil {
+			return err
+		}
+		if err := f.SetCellStyle(sheetName, footerCell, footerCell, style); err != nil {
+			return fmt.Errorf("error setting Excel footer style: %w", err)
+		}
+		hasFooter = true
+	}
+	if hasFooter {
+		if err := calcOnLoad(f); err != nil {
+			return err
+		}
+	}
+
+	panes := excelHeaderPanes
+	if err := f.SetPanes(sheetName, &panes); err != nil {
+		return fmt.Errorf("error freezing Excel header row: %w", err)
+	}
+	if err := f.AutoFilter(sheetName, "A1:"+lastCol+strconv.Itoa(lastRow), nil); err != nil {
+		return fmt.Errorf("error setting Excel auto-filter: %w", err)
+	}
+
+	for colIdx, length := range lengths {
+		colName, err := excelize.ColumnNumberToName(colIdx + 1)
+		if err != nil {
+			continue // Skip on error, don't fail entire export
+		}
+		if err := f.SetColWidth(sheetName, colName, colName, excelColumnWidth(length)); err != nil {
+			continue // Skip on error, don't fail entire export
+		}
+	}
+	return nil
+}
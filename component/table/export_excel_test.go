@@ -0,0 +1,225 @@
+package table
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/response"
+	"github.com/xuri/excelize/v2"
+)
+
+// Test row struct
+type testExcelRow struct {
+	Name     string
+	Trips    int
+	Distance float64
+	Start    time.Time
+	Active   bool
+}
+
+func buildTestExcelTable() *Table[testExcelRow] {
+	builder := NewBuilder[testExcelRow](testContext(), testTranslator)
+	builder.SetTitle("Trips")
+	builder.TextField("name", "device.name", func(r testExcelRow) string { return r.Name }).WithFooterCount()
+	builder.IntField("trips", "trip.count", func(r testExcelRow) int { return r.Trips })
+	builder.DistanceField("distance", "trip.distance", func(r testExcelRow) float64 { return r.Distance }).
+		WithDecimals(1).WithFooterSum()
+	builder.DateTimeField("start", "trip.start", func(r testExcelRow) time.Time { return r.Start })
+	builder.BoolField("active", "device.active", func(r testExcelRow) bool { return r.Active })
+	tbl := builder.Build()
+	tbl.SetData(testExcelData)
+	tbl.SetOutputType(OutputExcel)
+	return tbl
+}
+
+var testExcelData = []testExcelRow{
+	{Name: "Truck 1", Trips: 1200, Distance: 12.34, Start: time.Date(2026, 3, 1, 12, 30, 0, 0, time.UTC), Active: true},
+	{Name: "Truck 2", Trips: 3, Distance: 0.5, Start: time.Date(2026, 7, 1, 22, 15, 0, 0, time.UTC)},
+}
+
+func openExcel(t *testing.T, data []byte) *excelize.File {
+	t.Helper()
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("Failed to open Excel output: %v", err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func cellNumFmt(t *testing.T, f *excelize.File, sheet, cell string) string {
+	t.Helper()
+	styleID, err := f.GetCellStyle(sheet, cell)
+	if err != nil {
+		t.Fatalf("Failed to read style of %s: %v", cell, err)
+	}
+	style, err := f.GetStyle(styleID)
+	if err != nil || style.CustomNumFmt == nil {
+		return ""
+	}
+	return *style.CustomNumFmt
+}
+
+// TestExcelTypedCells verifies native cell types, number formats, footer formulas and layout
+func TestExcelTypedCells(t *testing.T) {
+	result := buildTestExcelTable().DataResponse(testTranslator)
+	if result.Type != response.ResponseExcel {
+		t.Fatalf("Expected ResponseExcel, got %v", result.Type)
+	}
+	f := openExcel(t, result.Body.([]byte))
+
+	types := map[string]excelize.CellType{
+		"A2": excelize.CellTypeSharedString,
+		"B2": excelize.CellTypeUnset, // numbers have no type attribute
+		"C2": excelize.CellTypeUnset,
+		"D2": excelize.CellTypeUnset,
+		"E2": excelize.CellTypeBool,
+	}
+	for cell, expected := range types {
+		if got, _ := f.GetCellType("Sheet1", cell); got != expected {
+			t.Errorf("Expected cell type %v for %s, got %v", expected, cell, got)
+		}
+	}
+
+	// Values in the user's timezone (Europe/Vienna) with the number formats
+	expected := map[string]string{"B2": "1,200", "C2": "12.3", "D2": "2026-03-01 13:30:00", "D3": "2026-07-02 00:15:00", "E2": "TRUE"}
+	for cell, value := range expected {
+		if got, _ := f.GetCellValue("Sheet1", cell); got != value {
+			t.Errorf("Expected %q in %s, got %q", value, cell, got)
+		}
+	}
+	if numFmt := cellNumFmt(t, f, "Sheet1", "C3"); numFmt != "#,##0.0" {
+		t.Errorf("Expected distance format #,##0.0, got %q", numFmt)
+	}
+
+	// Footer formulas below the data
+	if formula, _ := f.GetCellFormula("Sheet1", "C4"); formula != "SUM(C2:C3)" {
+		t.Errorf("Expected SUM footer formula, got %q", formula)
+	}
+	if formula, _ := f.GetCellFormula("Sheet1", "A4"); formula != "COUNTA(A2:A3)" {
+		t.Errorf("Expected COUNTA footer formula, got %q", formula)
+	}
+	if formula, _ := f.GetCellFormula("Sheet1", "B4"); formula != "" {
+		t.Errorf("Expected no footer for trips, got %q", formula)
+	}
+
+	panes, err := f.GetPanes("Sheet1")
+	if err != nil || !panes.Freeze || panes.YSplit != 1 {
+		t.Errorf("Expected frozen header row, got %+v (%v)", panes, err)
+	}
+}
+
+// TestExcelEmptyData verifies an export without rows has the header only
+func TestExcelEmptyData(t *testing.T) {
+	tbl := buildTestExcelTable()
+	tbl.SetData(nil)
+	f := openExcel(t, tbl.DataResponse(testTranslator).Body.([]byte))
+
+	rows, err := f.GetRows("Sheet1")
+	if err != nil || len(rows) != 1 || rows[0][0] != "Device Name" {
+		t.Errorf("Expected header row only, got %v (%v)", rows, err)
+	}
+}
+
+// TestWriteExcelTyped verifies the streaming export writes typed cells and footer formulas
+func TestWriteExcelTyped(t *testing.T) {
+	var buf bytes.Buffer
+	if err := buildTestExcelTable().WriteExcel(&buf, slices.Values(testExcelData)); err != nil {
+		t.Fatalf("WriteExcel failed: %v", err)
+	}
+	f := openExcel(t, buf.Bytes())
+
+	if got, _ := f.GetCellValue("Sheet1", "C2"); got != "12.3" {
+		t.Errorf("Expected formatted distance 12.3, got %q", got)
+	}
+	if got, _ := f.GetCellType("Sheet1", "E2"); got != excelize.CellTypeBool {
+		t.Errorf("Expected boolean cell, got %v", got)
+	}
+	if formula, _ := f.GetCellFormula("Sheet1", "C4"); formula != "SUM(C2:C3)" {
+		t.Errorf("Expected SUM footer formula, got %q", formula)
+	}
+}
+
+// TestWorkbook verifies a workbook with summary and tables of different row types
+func TestWorkbook(t *testing.T) {
+	status := field.NewSelectField("status", "device.status", false, []field.SelectOption{
+		{Value: 1, Label: "Online"},
+		{Value: 2, Label: "Offline"},
+	})
+	builder := NewBuilder[testExcelRow](testContext(), testTranslator)
+	builder.SetTitle("Trips")
+	builder.SetFilter(group.NewFormGroup([]field.FormField{status}))
+	builder.TextField("name", "device.name", func(r testExcelRow) string { return r.Name })
+	trips := builder.Build()
+	trips.SetData(testExcelData)
+	trips.SetFilterData(map[string]any{"status": float64(2)})
+	devices := buildTestStreamTable()
+	devices.SetData(testStreamData)
+
+	wb := NewWorkbook(testContext(), testTranslator, "Fleet report").
+		SetSummary("Filter", trips.FilterSummary()).
+		AddTable("", trips).
+		AddTable("", devices)
+
+	result, err := wb.DataResponse()
+	if err != nil {
+		t.Fatalf("DataResponse failed: %v", err)
+	}
+	f := openExcel(t, result.Body.([]byte))
+
+	if sheets := f.GetSheetList(); !slices.Equal(sheets, []string{"Filter", "Trips", "Sheet3"}) {
+		t.Errorf("Unexpected sheets %v", sheets)
+	}
+	if got, _ := f.GetCellValue("Filter", "B1"); got != "Offline" {
+		t.Errorf("Expected option name in filter summary, got %q", got)
+	}
+	if got, _ := f.GetCellValue("Sheet3", "A3"); got != "Truck;2" {
+		t.Errorf("Expected device rows on third sheet, got %q", got)
+	}
+	if result.Type != response.ResponseExcel || !strings.HasPrefix(result.Filename, "Fleet_report_") {
+		t.Errorf("Unexpected result %v %q", result.Type, result.Filename)
+	}
+
+	// No summary sheet without active filters
+	trips.SetFilterData(map[string]any{})
+	if summary := trips.FilterSummary(); summary != nil {
+		t.Errorf("Expected nil summary without filters, got %v", summary)
+	}
+	result, err = NewWorkbook(testContext(), testTranslator, "Fleet report").
+		SetSummary("Filter", []SummaryRow{}).
+		AddTable("", trips).
+		DataResponse()
+	if err != nil {
+		t.Fatalf("DataResponse failed: %v", err)
+	}
+	if sheets := openExcel(t, result.Body.([]byte)).GetSheetList(); !slices.Equal(sheets, []string{"Trips"}) {
+		t.Errorf("Expected no summary sheet, got %v", sheets)
+	}
+}
+
+// TestExcelSheetName verifies invalid characters, length and uniqueness of sheet names
+func TestExcelSheetName(t *testing.T) {
+	used := make(map[string]bool)
+	names := []string{
+		excelSheetName("Trips 2026/03", 1, used),
+		excelSheetName("trips 2026/03", 2, used),
+		excelSheetName("", 3, used),
+		excelSheetName("A very long sheet name that exceeds the limit", 4, used),
+		excelSheetName("A very long sheet name that exceeds the limit", 5, used),
+	}
+	expected := []string{
+		"Trips 2026_03",
+		"trips 2026_03 (2)",
+		"Sheet3",
+		"A very long sheet name that exc",
+		"A very long sheet name that (2)",
+	}
+	if !slices.Equal(names, expected) {
+		t.Errorf("Expected %q, got %q", expected, names)
+	}
+}
@@ -0,0 +1,164 @@
+package table
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/xiriframework/xiri-go/request"
+	"github.com/xiriframework/xiri-go/response"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// TestJSONLExport verifies one object per row with raw values and JSONL formatters
+func TestJSONLExport(t *testing.T) {
+	builder := NewBuilder[testExcelRow](testContext(), testTranslator)
+	builder.TextField("name", "device.name", func(r testExcelRow) string { return r.Name })
+	builder.DistanceField("distance", "trip.distance", func(r testExcelRow) float64 { return r.Distance }).
+		WithFooterSum()
+	builder.IntField("trips", "trip.count", func(r testExcelRow) int { return r.Trips }).
+		WithJSONLFormatter(FormatterFunc(func(value any, row Row, output OutputType, ctx *uicontext.UiContext) any {
+			return value.(int) * 2
+		}))
+	tbl := builder.Build()
+	tbl.SetData(testExcelData)
+	tbl.SetOutputType(OutputJSONL)
+
+	result := tbl.DataResponse(testTranslator)
+	if result.Type != response.ResponseJSONL || result.Filename == "" {
+		t.Fatalf("Unexpected result %v %q", result.Type, result.Filename)
+	}
+	lines := strings.Split(strings.TrimSuffix(result.Body.(string), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %q", result.Body)
+	}
+	var row map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &row); err != nil {
+		t.Fatalf("Invalid JSON line %q: %v", lines[0], err)
+	}
+	if row["name"] != "Truck 1" || row["distance"] != 12.34 || row["trips"] != float64(2400) || len(row) != 3 {
+		t.Errorf("Unexpected row %v", row)
+	}
+}
+
+// TestODSExport verifies the ODS package structure, typed cells and footer formulas
+func TestODSExport(t *testing.T) {
+	tbl := buildTestExcelTable()
+	tbl.SetOutputType(OutputODS)
+	result := tbl.DataResponse(testTranslator)
+	if result.Type != response.ResponseODS {
+		t.Fatalf("Expected ResponseODS, got %v", result.Type)
+	}
+
+	data := result.Body.([]byte)
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Failed to open ODS output: %v", err)
+	}
+	if first := zr.File[0]; first.Name != "mimetype" || first.Method != zip.Store {
+		t.Errorf("Expected uncompressed mimetype as first entry, got %s (%d)", first.Name, first.Method)
+	}
+	var content string
+	for _, file := range zr.File {
+		if file.Name == "content.xml" {
+			rc, err := file.Open()
+			if err != nil {
+				t.Fatalf("Failed to open content.xml: %v", err)
+			}
+			b, _ := io.ReadAll(rc)
+			rc.Close()
+			content = string(b)
+		}
+	}
+
+	expected := []string{
+		`table:name="Trips"`,
+		`office:value-type="float" office:value="1200"`,
+		`office:value-type="float" office:value="12.3"`,
+		`office:date-value="2026-03-01T13:30:00"`,
+		`office:value-type="boolean" office:boolean-value="true"`,
+		`<text:p>Truck 1</text:p>`,
+		`table:formula="of:=SUM([.C2:.C3])" office:value-type="float" office:value="12.8"`,
+		`table:formula="of:=COUNTA([.A2:.A3])" office:value-type="float" office:value="2"`,
+		`number:decimal-places="1" number:min-integer-digits="1" number:grouping="true"`,
+	}
+	for _, s := range expected {
+		if !strings.Contains(content, s) {
+			t.Errorf("Expected %q in content.xml", s)
+		}
+	}
+}
+
+// TestHTMLExport verifies the self-contained HTML report with escaped values and inline styles
+func TestHTMLExport(t *testing.T) {
+	builder := NewBuilder[testExcelRow](testContext(), testTranslator)
+	builder.SetTitle("Trips <2026>")
+	builder.TextField("name", "device.name", func(r testExcelRow) string { return r.Name }).WithFooterCount()
+	builder.IntField("trips", "trip.count", func(r testExcelRow) int { return r.Trips }).
+		WithHTMLFormatter(FormatterFunc(func(value any, row Row, output OutputType, ctx *uicontext.UiContext) any {
+			return "<b>"
+		}))
+	tbl := builder.Build()
+	tbl.SetData(testExcelData)
+	tbl.SetOutputType(OutputHTML)
+
+	result := tbl.DataResponse(testTranslator)
+	if result.Type != response.ResponseHTML {
+		t.Fatalf("Expected ResponseHTML, got %v", result.Type)
+	}
+	body := result.Body.(string)
+	expected := []string{
+		"<!DOCTYPE html>",
+		"<h1 style=\"" + htmlTitleStyle + "\">Trips &lt;2026&gt;</h1>",
+		"<th style=\"" + htmlHeaderStyle + "text-align:left;\">Device Name</th>",
+		"<td style=\"" + htmlCellStyle + "text-align:left;\">Truck 1</td>",
+		"&lt;b&gt;",
+		"<tfoot>",
+	}
+	for _, s := range expected {
+		if !strings.Contains(body, s) {
+			t.Errorf("Expected %q in HTML, got %s", s, body)
+		}
+	}
+	if strings.Contains(body, "<style") {
+		t.Error("Expected inline styles only")
+	}
+}
+
+// TestHTMLExportFallbackColumns verifies sorted columns without field definitions
+func TestHTMLExportFallbackColumns(t *testing.T) {
+	td := &TableDataResponse{data: []map[string]any{{"name": "Truck", "city": "Vienna", "trips": 4}}}
+
+	body := td.generateHTML(testTranslator)
+	city, name, trips := strings.Index(body, ">city<"), strings.Index(body, ">name<"), strings.Index(body, ">trips<")
+	if city < 0 || !(city < name && name < trips) {
+		t.Errorf("Expected sorted fallback columns, got %s", body)
+	}
+}
+
+// TestExportFormatFlags verifies the _jsonl, _ods and _html request flags
+func TestExportFormatFlags(t *testing.T) {
+	flags := map[string]OutputType{"_jsonl": OutputJSONL, "_ods": OutputODS, "_html": OutputHTML}
+	for flag, expected := range flags {
+		tbl := buildTestExcelTable()
+		tbl.SetOutputType(OutputWeb)
+
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"`+flag+`": true, "name": "x"}`))
+		req.Header.Set("Content-Type", "application/json")
+		filters, err := tbl.LoadFilterData(request.FromHTTP(req))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if tbl.GetOutputType() != expected {
+			t.Errorf("Expected %v for %s, got %v", expected, flag, tbl.GetOutputType())
+		}
+		if _, ok := filters[flag]; ok || filters["name"] != "x" {
+			t.Errorf("Expected %s removed from filters, got %v", flag, filters)
+		}
+	}
+}
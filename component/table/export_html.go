@@ -0,0 +1,121 @@
+package table
+
+import (
+	"html"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/xiriframework/xiri-go/component/core"
+)
+
+// Inline styles of the HTML export. Email clients ignore <style> blocks, so every
+// element carries its own style attribute.
+const (
+	htmlBodyStyle   = "margin:0;padding:16px;font-family:Arial,Helvetica,sans-serif;font-size:13px;color:#222222;"
+	htmlTitleStyle  = "margin:0 0 12px 0;font-size:18px;font-weight:bold;"
+	htmlTableStyle  = "border-collapse:collapse;"
+	htmlHeaderStyle = "padding:4px 8px;border:1px solid #cccccc;background-color:#e6e6e6;font-weight:bold;"
+	htmlCellStyle   = "padding:4px 8px;border:1px solid #cccccc;vertical-align:top;"
+	htmlFooterStyle = "padding:4px 8px;border:1px solid #cccccc;background-color:#f5f5f5;font-weight:bold;"
+)
+
+// generateHTML creates a self-contained HTML document with the table data, e.g. for
+// email reports. Uses the same field selection as CSV/Excel (csv-enabled, non-hidden
+// fields) and the PDF formatters unless a field has an HTML formatter.
+//
+// Layout:
+//   - Title (from table options) as heading
+//   - Translated header row
+//   - Footer aggregates (from CalculateFooter) after the last data row
+//
+// Multi-line values (Text2, TextN fields) are rendered as lines separated by <br>.
+func (td *TableDataResponse) generateHTML(translator core.TranslateFunc) string {
+	fieldsToUse := td.fieldsForCSV
+	if fieldsToUse == nil {
+		fieldsToUse = td.fields
+	}
+
+	// Build ordered column list from field definitions
+	type htmlColumn struct {
+		id    string
+		name  string
+		align string
+	}
+	columns := make([]htmlColumn, 0, len(fieldsToUse))
+	for _, fieldDef := range fieldsToUse {
+		fieldID, hasID := fieldDef["id"].(string)
+		if !hasID {
+			continue
+		}
+		name, _ := fieldDef["name"].(string)
+		if name == "" {
+			name = fieldID
+		}
+		align := "left"
+		switch fieldDef["align"] {
+		case string(FieldAlignRight):
+			align = "right"
+		case string(FieldAlignCenter):
+			align = "center"
+		}
+		columns = append(columns, htmlColumn{id: fieldID, name: name, align: align})
+	}
+
+	// Fallback: if no field definitions, use keys from first row (sorted for a stable order)
+	if len(columns) == 0 && len(td.data) > 0 {
+		for _, fieldID := range slices.Sorted(maps.Keys(td.data[0])) {
+			columns = append(columns, htmlColumn{id: fieldID, name: fieldID, align: "left"})
+		}
+	}
+
+	title := html.EscapeString(td.reportTitle(translator))
+
+	var b strings.Builder
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"UTF-8\">\n")
+	b.WriteString("<title>" + title + "</title>\n</head>\n")
+	b.WriteString("<body style=\"" + htmlBodyStyle + "\">\n")
+	if title != "" {
+		b.WriteString("<h1 style=\"" + htmlTitleStyle + "\">" + title + "</h1>\n")
+	}
+	b.WriteString("<table style=\"" + htmlTableStyle + "\" cellpadding=\"0\" cellspacing=\"0\">\n")
+
+	// writeRow writes one table row, cell tag and style for header, data or footer
+	writeRow := func(tag string, style string, cell func(col htmlColumn) string) {
+		b.WriteString("<tr>")
+		for _, col := range columns {
+			b.WriteString("<" + tag + " style=\"" + style + "text-align:" + col.align + ";\">")
+			b.WriteString(cell(col))
+			b.WriteString("</" + tag + ">")
+		}
+		b.WriteString("</tr>\n")
+	}
+	cellValue := func(rowData map[string]any) func(col htmlColumn) string {
+		return func(col htmlColumn) string {
+			lines := pdfCellLines(rowData[col.id])
+			escaped := make([]string, len(lines))
+			for i, line := range lines {
+				escaped[i] = html.EscapeString(line)
+			}
+			return strings.Join(escaped, "<br>")
+		}
+	}
+
+	b.WriteString("<thead>\n")
+	writeRow("th", htmlHeaderStyle, func(col htmlColumn) string { return html.EscapeString(col.name) })
+	b.WriteString("</thead>\n<tbody>\n")
+	for _, rowData := range td.data {
+		writeRow("td", htmlCellStyle, cellValue(rowData))
+	}
+	b.WriteString("</tbody>\n")
+
+	// Footer aggregates
+	if len(td.footer) > 0 && len(td.data) > 0 {
+		b.WriteString("<tfoot>\n")
+		writeRow("td", htmlFooterStyle, cellValue(td.footer))
+		b.WriteString("</tfoot>\n")
+	}
+
+	b.WriteString("</table>\n</body>\n</html>\n")
+	return b.String()
+}
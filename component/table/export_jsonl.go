@@ -0,0 +1,29 @@
+package table
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// generateJSONL creates JSON Lines output from the table data: one JSON object per row,
+// keyed by field ID. Values are the raw accessor values unless a field has a JSONL
+// formatter (see WithJSONLFormatter). Only includes fields that are marked as CSV-enabled.
+func (td *TableDataResponse) generateJSONL() (string, error) {
+	ids, _ := td.exportColumns()
+
+	buf := new(bytes.Buffer)
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	for _, rowData := range td.data {
+		row := make(map[string]any, len(ids))
+		for _, fieldID := range ids {
+			row[fieldID] = rowData[fieldID]
+		}
+		// Encode writes one line per object
+		if err := encoder.Encode(row); err != nil {
+			return "", fmt.Errorf("error writing JSON Lines row: %w", err)
+		}
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,312 @@
+package table
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/xiriframework/xiri-go/component/core"
+	"github.com/xuri/excelize/v2"
+)
+
+// ============================================================================
+// OpenDocument Spreadsheet (ODS)
+// ============================================================================
+//
+// ODS exports contain the same sheet as the Excel export (see excelSheet): typed
+// cells (float, date, boolean, string) with number formats derived from the
+// Excel number formats, a bold header row that is repeated when printing and a
+// footer row with SUM / COUNTA formulas. The footer values are written as cached
+// results, so viewers that do not recalculate still show them.
+//
+// The file is a zip archive with an uncompressed "mimetype" entry first, a
+// manifest and the content.xml.
+
+const odsMimeType = "application/vnd.oasis.opendocument.spreadsheet"
+
+const odsManifest = `<?xml version="1.0" encoding="UTF-8"?>
+<manifest:manifest xmlns:manifest="urn:oasis:names:tc:opendocument:xmlns:manifest:1.0" manifest:version="1.2">
+ <manifest:file-entry manifest:full-path="/" manifest:version="1.2" manifest:media-type="` + odsMimeType + `"/>
+ <manifest:file-entry manifest:full-path="content.xml" manifest:media-type="text/xml"/>
+</manifest:manifest>
+`
+
+const odsContentStart = `<?xml version="1.0" encoding="UTF-8"?>
+<office:document-content` +
+	` xmlns:office="urn:oasis:names:tc:opendocument:xmlns:office:1.0"` +
+	` xmlns:style="urn:oasis:names:tc:opendocument:xmlns:style:1.0"` +
+	` xmlns:text="urn:oasis:names:tc:opendocument:xmlns:text:1.0"` +
+	` xmlns:table="urn:oasis:names:tc:opendocument:xmlns:table:1.0"` +
+	` xmlns:fo="urn:oasis:names:tc:opendocument:xmlns:xsl-fo-compatible:1.0"` +
+	` xmlns:number="urn:oasis:names:tc:opendocument:xmlns:datastyle:1.0"` +
+	` xmlns:of="urn:oasis:names:tc:opendocument:xmlns:of:1.2"` +
+	` office:version="1.2">
+`
+
+// odsStyles collects the automatic cell styles and data styles of a document.
+type odsStyles struct {
+	buf   bytes.Buffer
+	names map[string]string
+}
+
+func newODSStyles() *odsStyles {
+	return &odsStyles{names: make(map[string]string)}
+}
+
+// cell returns the name of the cell style with the number format (Excel notation,
+// may be "") and optionally bold font ("" = default style).
+func (s *odsStyles) cell(numFmt string, bold bool) string {
+	if numFmt == "" && !bold {
+		return ""
+	}
+	key := numFmt + "|" + strconv.FormatBool(bold)
+	if name, ok := s.names[key]; ok {
+		return name
+	}
+	name := "ce" + strconv.Itoa(len(s.names)+1)
+	s.names[key] = name
+
+	dataStyle := ""
+	if numFmt != "" {
+		dataStyle = "N" + strconv.Itoa(len(s.names))
+		s.buf.WriteString(odsDataStyle(dataStyle, numFmt))
+	}
+	s.buf.WriteString(`<style:style style:name="` + name + `" style:family="table-cell"`)
+	if dataStyle != "" {
+		s.buf.WriteString(` style:data-style-name="` + dataStyle + `"`)
+	}
+	if bold {
+		s.buf.WriteString(`><style:text-properties fo:font-weight="bold"/></style:style>`)
+	} else {
+		s.buf.WriteString(`/>`)
+	}
+	s.buf.WriteString("\n")
+	return name
+}
+
+// column returns a table-column style with the width for the longest content
+// (see excelColumnWidth, about 0.19 cm per character).
+func (s *odsStyles) column(index int, length int) string {
+	name := "co" + strconv.Itoa(index+1)
+	fmt.Fprintf(&s.buf, `<style:style style:name="%s" style:family="table-column">`+
+		`<style:table-column-properties style:column-width="%.2fcm"/></style:style>`+"\n",
+		name, excelColumnWidth(length)*0.19)
+	return name
+}
+
+// odsDataStyle converts an Excel number format of excelNumberFormat into an ODS data style:
+// date formats ("yyyy-mm-dd", "yyyy-mm-dd hh:mm:ss") and number formats ("0", "#,##0",
+// "#,##0.00").
+func odsDataStyle(name string, numFmt string) string {
+	if strings.HasPrefix(numFmt, "yyyy-mm-dd") {
+		style := `<number:date-style style:name="` + name + `">` +
+			`<number:year number:style="long"/><number:text>-</number:text>` +
+			`<number:month number:style="long"/><number:text>-</number:text>` +
+			`<number:day number:style="long"/>`
+		if strings.Contains(numFmt, "hh:mm") {
+			style += `<number:text> </number:text><number:hours number:style="long"/>` +
+				`<number:text>:</number:text><number:minutes number:style="long"/>` +
+				`<number:text>:</number:text><number:seconds number:style="long"/>`
+		}
+		return style + "</number:date-style>\n"
+	}
+
+	decimals := 0
+	if _, frac, ok := strings.Cut(numFmt, "."); ok {
+		decimals = len(frac)
+	}
+	return fmt.Sprintf(`<number:number-style style:name="%s">`+
+		`<number:number number:decimal-places="%d" number:min-integer-digits="1" number:grouping="%t"/>`+
+		"</number:number-style>\n", name, decimals, strings.Contains(numFmt, ","))
+}
+
+// odsEscape escapes text for XML content and attribute values.
+func odsEscape(s string) string {
+	var buf strings.Builder
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// odsCell writes a table cell with the value type of the value (nil = empty cell).
+// A formula is written with the value as cached result.
+func odsCell(buf *bytes.Buffer, value any, styleName string, formula string) {
+	buf.WriteString("<table:table-cell")
+	if styleName != "" {
+		buf.WriteString(` table:style-name="` + styleName + `"`)
+	}
+	if formula != "" {
+		buf.WriteString(` table:formula="` + odsEscape(formula) + `"`)
+	}
+
+	var text string
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("/>")
+		return
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		text = fmt.Sprint(v)
+		buf.WriteString(` office:value-type="float" office:value="` + text + `"`)
+	case time.Time:
+		text = v.Format("2006-01-02 15:04:05")
+		buf.WriteString(` office:value-type="date" office:date-value="` + v.Format("2006-01-02T15:04:05") + `"`)
+	case bool:
+		text = strings.ToUpper(strconv.FormatBool(v))
+		buf.WriteString(` office:value-type="boolean" office:boolean-value="` + strconv.FormatBool(v) + `"`)
+	default:
+		text = fmt.Sprint(v)
+		buf.WriteString(` office:value-type="string"`)
+	}
+	buf.WriteString(">")
+	// One paragraph per line (multi-line text fields)
+	for _, line := range strings.Split(text, "\n") {
+		buf.WriteString("<text:p>" + odsEscape(line) + "</text:p>")
+	}
+	buf.WriteString("</table:table-cell>")
+}
+
+// odsFooterFormula returns the OpenFormula footer formula of a column over the data
+// rows (rows 2 to lastRow), or "" if the column has no footer aggregation.
+func odsFooterFormula(col string, footer FieldFooter, lastRow int) string {
+	switch footer {
+	case FieldFooterSum:
+		return fmt.Sprintf("of:=SUM([.%s2:.%s%d])", col, col, lastRow)
+	case FieldFooterCount:
+		return fmt.Sprintf("of:=COUNTA([.%s2:.%s%d])", col, col, lastRow)
+	}
+	return ""
+}
+
+// odsFooterValue calculates the result of the footer formula of a column: the sum of
+// the numeric values or the number of non-empty cells.
+func odsFooterValue(rows [][]any, colIdx int, footer FieldFooter) float64 {
+	result := 0.0
+	for _, row := range rows {
+		switch value := row[colIdx].(type) {
+		case nil:
+		case string:
+			if footer == FieldFooterCount && value != "" {
+				result++
+			}
+		default:
+			if footer == FieldFooterCount {
+				result++
+			} else {
+				result += toFloat64(value)
+			}
+		}
+	}
+	return result
+}
+
+// generateODS creates an OpenDocument spreadsheet (.ods) from the table data.
+// Only includes fields that are marked as CSV-enabled (same logic as CSV and Excel).
+func (td *TableDataResponse) generateODS(translator core.TranslateFunc) ([]byte, error) {
+	sheet := td.excelSheet()
+	styles := newODSStyles()
+	lastRow := len(sheet.rows) + 1
+
+	// Rows are written first, the styles they use are collected meanwhile
+	lengths := make([]int, len(sheet.columns))
+	rows := new(bytes.Buffer)
+
+	rows.WriteString("<table:table-header-rows><table:table-row>")
+	headerStyle := styles.cell("", true)
+	for colIdx, col := range sheet.columns {
+		odsCell(rows, col.name, headerStyle, "")
+		lengths[colIdx] = utf8.RuneCountInString(col.name)
+	}
+	rows.WriteString("</table:table-row></table:table-header-rows>\n")
+
+	for _, row := range sheet.rows {
+		rows.WriteString("<table:table-row>")
+		for colIdx, value := range row {
+			odsCell(rows, value, styles.cell(sheet.columns[colIdx].numFmt, false), "")
+			if value != nil {
+				lengths[colIdx] = max(lengths[colIdx], excelValueLength(value, sheet.columns[colIdx].numFmt))
+			}
+		}
+		rows.WriteString("</table:table-row>\n")
+	}
+
+	// Footer formulas below the data, with the calculated footer as cached value
+	hasFooter := false
+	footer := new(bytes.Buffer)
+	footer.WriteString("<table:table-row>")
+	for colIdx, col := range sheet.columns {
+		colName, err := excelize.ColumnNumberToName(colIdx + 1)
+		if err != nil {
+			return nil, fmt.Errorf("error getting ODS column name: %w", err)
+		}
+		formula := odsFooterFormula(colName, col.footer, lastRow)
+		if formula == "" || len(sheet.rows) == 0 {
+			odsCell(footer, nil, "", "")
+			continue
+		}
+		odsCell(footer, odsFooterValue(sheet.rows, colIdx, col.footer), styles.cell(excelFooterFormat(col.excelFormat), true), formula)
+		hasFooter = true
+	}
+	footer.WriteString("</table:table-row>\n")
+	if hasFooter {
+		rows.Write(footer.Bytes())
+	}
+
+	columns := new(bytes.Buffer)
+	for colIdx, length := range lengths {
+		columns.WriteString(`<table:table-column table:style-name="` + styles.column(colIdx, length) + `"/>`)
+	}
+	if len(lengths) == 0 {
+		columns.WriteString("<table:table-column/>") // A table needs at least one column
+	}
+
+	content := new(bytes.Buffer)
+	content.WriteString(odsContentStart)
+	content.WriteString("<office:automatic-styles>\n")
+	content.Write(styles.buf.Bytes())
+	content.WriteString("</office:automatic-styles>\n")
+	content.WriteString(`<office:body><office:spreadsheet><table:table table:name="` +
+		odsEscape(excelSheetName(td.reportTitle(translator), 1, make(map[string]bool))) + `">` + "\n")
+	content.Write(columns.Bytes())
+	content.WriteString("\n")
+	content.Write(rows.Bytes())
+	content.WriteString("</table:table></office:spreadsheet></office:body></office:document-content>\n")
+
+	buf := new(bytes.Buffer)
+	zw := zip.NewWriter(buf)
+	// The mimetype must be the first entry and stored uncompressed
+	entries := []struct {
+		name   string
+		method uint16
+		data   []byte
+	}{
+		{"mimetype", zip.Store, []byte(odsMimeType)},
+		{"META-INF/manifest.xml", zip.Deflate, []byte(odsManifest)},
+		{"content.xml", zip.Deflate, content.Bytes()},
+	}
+	for _, entry := range entries {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: entry.name, Method: entry.method})
+		if err != nil {
+			return nil, fmt.Errorf("error creating ODS entry %s: %w", entry.name, err)
+		}
+		if _, err := w.Write(entry.data); err != nil {
+			return nil, fmt.Errorf("error writing ODS entry %s: %w", entry.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("error writing ODS file: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// reportTitle returns the translated report title ("" if not set).
+func (td *TableDataResponse) reportTitle(translator core.TranslateFunc) string {
+	if td.title == nil {
+		return ""
+	}
+	return translate(translator, *td.title)
+}
@@ -0,0 +1,332 @@
+package table
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/xiriframework/xiri-go/component/core"
+)
+
+// PDF layout constants (millimeters / points)
+const (
+	pdfMargin         = 10.0
+	pdfLineHeight     = 5.0
+	pdfCellPadding    = 1.5
+	pdfTitleFontSize  = 14.0
+	pdfFontSize       = 8.0
+	pdfMinColumnWidth = 12.0
+	pdfLandscapeAbove = 6 // Switch to landscape when more columns than this
+)
+
+// generatePDF creates a paginated PDF report from the table data.
+// Uses the same field selection as CSV/Excel (csv-enabled, non-hidden fields).
+//
+// Layout:
+//   - Title (from table options) at the top of every page
+//   - Translated header row, repeated on every page
+//   - Footer aggregates (from CalculateFooter) after the last data row
+//   - Page numbers "n / total" at the bottom of every page
+//
+// Multi-line values (Text2, TextN fields) are rendered as multiple lines within a cell.
+// Text wider than its column is wrapped at spaces (long words are broken), so the
+// row grows instead of the text running into the next column. Rows taller than a
+// page are continued on the next page.
+func (td *TableDataResponse) generatePDF(translator core.TranslateFunc) ([]byte, error) {
+	fieldsToUse := td.fieldsForCSV
+	if fieldsToUse == nil {
+		fieldsToUse = td.fields
+	}
+
+	// Build ordered column list from field definitions
+	type pdfColumn struct {
+		id    string
+		name  string
+		align string
+		width float64
+	}
+	columns := make([]*pdfColumn, 0, len(fieldsToUse))
+	for _, fieldDef := range fieldsToUse {
+		fieldID, hasID := fieldDef["id"].(string)
+		if !hasID {
+			continue
+		}
+		name, _ := fieldDef["name"].(string)
+		if name == "" {
+			name = fieldID
+		}
+		align := "L"
+		switch fieldDef["align"] {
+		case string(FieldAlignRight):
+			align = "R"
+		case string(FieldAlignCenter):
+			align = "C"
+		}
+		columns = append(columns, &pdfColumn{id: fieldID, name: name, align: align})
+	}
+
+	// Fallback: if no field definitions, use keys from first row (sorted for a stable order)
+	if len(columns) == 0 && len(td.data) > 0 {
+		for _, fieldID := range slices.Sorted(maps.Keys(td.data[0])) {
+			columns = append(columns, &pdfColumn{id: fieldID, name: fieldID, align: "L"})
+		}
+	}
+
+	orientation := "P"
+	if len(columns) > pdfLandscapeAbove {
+		orientation = "L"
+	}
+
+	pdf := fpdf.New(orientation, "mm", "A4", "")
+	pdf.SetMargins(pdfMargin, pdfMargin, pdfMargin)
+	pdf.SetAutoPageBreak(false, pdfMargin)
+	pdf.AliasNbPages("")
+
+	// Core fonts are cp1252 encoded - translate UTF-8 input (umlauts, €, etc.)
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+
+	title := td.reportTitle(translator)
+
+	pdf.SetFooterFunc(func() {
+		pdf.SetY(-pdfMargin)
+		pdf.SetFont("Helvetica", "", pdfFontSize)
+		pdf.CellFormat(0, pdfLineHeight, fmt.Sprintf("%d / {nb}", pdf.PageNo()), "", 0, "C", false, 0, "")
+	})
+
+	// Measure column widths: widest header or cell text, scaled to the page width
+	pageWidth, pageHeight := pdf.GetPageSize()
+	usableWidth := pageWidth - 2*pdfMargin
+
+	pdf.SetFont("Helvetica", "B", pdfFontSize)
+	for _, col := range columns {
+		col.width = pdf.GetStringWidth(tr(col.name)) + 2*pdfCellPadding
+	}
+	pdf.SetFont("Helvetica", "", pdfFontSize)
+	measure := func(rowData map[string]any) {
+		for _, col := range columns {
+			for _, line := range pdfCellLines(rowData[col.id]) {
+				w := pdf.GetStringWidth(tr(line)) + 2*pdfCellPadding
+				if w > col.width {
+					col.width = w
+				}
+			}
+		}
+	}
+	for _, rowData := range td.data {
+		measure(rowData)
+	}
+	if td.footer != nil {
+		measure(td.footer)
+	}
+
+	totalWidth := 0.0
+	for _, col := range columns {
+		if col.width < pdfMinColumnWidth {
+			col.width = pdfMinColumnWidth
+		}
+		totalWidth += col.width
+	}
+	if totalWidth > 0 {
+		scale := usableWidth / totalWidth
+		for _, col := range columns {
+			col.width *= scale
+		}
+	}
+
+	// wrapCells translates the cell lines of a row and wraps them to the column widths
+	wrapCells := func(values [][]string, style string) [][]string {
+		pdf.SetFont("Helvetica", style, pdfFontSize)
+		wrapped := make([][]string, len(values))
+		for i, col := range columns {
+			for _, line := range values[i] {
+				wrapped[i] = append(wrapped[i], pdfWrapLine(pdf, tr(line), col.width-2*pdfCellPadding)...)
+			}
+		}
+		return wrapped
+	}
+
+	// writeRow draws one table row with all lines of multi-line cells (wrapped by wrapCells)
+	// on a background of the gray level fill (0 = none)
+	writeRow := func(values [][]string, bold bool, fill int) {
+		style := ""
+		if bold {
+			style = "B"
+		}
+		pdf.SetFont("Helvetica", style, pdfFontSize)
+		if fill > 0 {
+			pdf.SetFillColor(fill, fill, fill)
+		}
+
+		rowHeight := float64(pdfRowLines(values)) * pdfLineHeight
+
+		x := pdfMargin
+		y := pdf.GetY()
+		for i, col := range columns {
+			pdf.SetXY(x, y)
+			pdf.CellFormat(col.width, rowHeight, "", "1", 0, "", fill > 0, 0, "")
+			for j, line := range values[i] {
+				pdf.SetXY(x, y+float64(j)*pdfLineHeight)
+				pdf.CellFormat(col.width, pdfLineHeight, line, "", 0, col.align, false, 0, "")
+			}
+			x += col.width
+		}
+		pdf.SetXY(pdfMargin, y+rowHeight)
+	}
+
+	headerValues := make([][]string, len(columns))
+	for i, col := range columns {
+		headerValues[i] = []string{col.name}
+	}
+	headerValues = wrapCells(headerValues, "B")
+
+	// newPage starts a page with title and repeated header row
+	pageBottom := pageHeight - 2*pdfMargin
+	pageTop := 0.0 // Y below the header row of the current page
+	newPage := func() {
+		pdf.AddPage()
+		if title != "" {
+			pdf.SetFont("Helvetica", "B", pdfTitleFontSize)
+			pdf.CellFormat(0, pdfTitleFontSize/2, tr(title), "", 1, "L", false, 0, "")
+			pdf.Ln(pdfLineHeight / 2)
+		}
+		writeRow(headerValues, true, 230)
+		pageTop = pdf.GetY()
+	}
+
+	rowValues := func(rowData map[string]any, style string) [][]string {
+		values := make([][]string, len(columns))
+		for i, col := range columns {
+			values[i] = pdfCellLines(rowData[col.id])
+		}
+		return wrapCells(values, style)
+	}
+
+	// writeRows writes a row, on a new page if it does not fit on the current one.
+	// Rows taller than a page are split: the lines that fit are written and the rest
+	// continues on the next page.
+	writeRows := func(values [][]string, bold bool, fill int) {
+		for {
+			fit := max(int((pageBottom-pdf.GetY())/pdfLineHeight), 0)
+			if pdfRowLines(values) <= fit {
+				writeRow(values, bold, fill)
+				return
+			}
+			if pdf.GetY() > pageTop {
+				newPage()
+				continue
+			}
+			head, rest := pdfSplitRow(values, max(fit, 1))
+			writeRow(head, bold, fill)
+			newPage()
+			values = rest
+		}
+	}
+
+	newPage()
+
+	for _, rowData := range td.data {
+		writeRows(rowValues(rowData, ""), false, 0)
+	}
+
+	// Footer aggregates
+	if len(td.footer) > 0 && len(td.data) > 0 {
+		writeRows(rowValues(td.footer, "B"), true, 245)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := pdf.Output(buf); err != nil {
+		return nil, fmt.Errorf("error writing PDF to buffer: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// pdfRowLines returns the number of lines of a row (the most lines of its cells, at least 1).
+func pdfRowLines(values [][]string) int {
+	lines := 1
+	for _, cellLines := range values {
+		lines = max(lines, len(cellLines))
+	}
+	return lines
+}
+
+// pdfSplitRow splits the cells of a row after n lines.
+func pdfSplitRow(values [][]string, n int) (head, rest [][]string) {
+	head = make([][]string, len(values))
+	rest = make([][]string, len(values))
+	for i, cellLines := range values {
+		k := min(n, len(cellLines))
+		head[i], rest[i] = cellLines[:k], cellLines[k:]
+	}
+	return head, rest
+}
+
+// pdfWrapLine splits a line into lines that fit into width with the current font,
+// breaking at spaces and inside words longer than width. The line must be translated
+// to the single-byte font encoding (see UnicodeTranslatorFromDescriptor).
+func pdfWrapLine(pdf *fpdf.Fpdf, line string, width float64) []string {
+	if pdf.GetStringWidth(line) <= width {
+		return []string{line}
+	}
+
+	var lines []string
+	current := ""
+	for _, word := range strings.Split(line, " ") {
+		candidate := word
+		if current != "" {
+			candidate = current + " " + word
+		}
+		if pdf.GetStringWidth(candidate) <= width {
+			current = candidate
+			continue
+		}
+		if current != "" {
+			lines = append(lines, current)
+		}
+		// Break words that do not fit on a line of their own
+		current = word
+		for len(current) > 1 && pdf.GetStringWidth(current) > width {
+			n := 1
+			for n < len(current)-1 && pdf.GetStringWidth(current[:n+1]) <= width {
+				n++
+			}
+			lines = append(lines, current[:n])
+			current = current[n:]
+		}
+	}
+	return append(lines, current)
+}
+
+// pdfCellLines converts a formatted cell value into display lines.
+// Handles the value shapes produced by the OutputPDF formatters:
+// plain values, [2]string (Text2 fields), []string (N fields) and [display, value] arrays.
+func pdfCellLines(value any) []string {
+	if value == nil {
+		return []string{""}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return strings.Split(v, "\n")
+	case [2]string:
+		if v[1] == "" {
+			return []string{v[0]}
+		}
+		return []string{v[0], v[1]}
+	case []string:
+		if len(v) == 0 {
+			return []string{""}
+		}
+		return v
+	case []any:
+		if len(v) == 0 {
+			return []string{""}
+		}
+		return []string{fmt.Sprint(v[0])}
+	}
+
+	return []string{fmt.Sprint(value)}
+}
@@ -0,0 +1,153 @@
+package table
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-pdf/fpdf"
+	"github.com/xiriframework/xiri-go/response"
+)
+
+// Test row struct
+type testPdfRow struct {
+	ID       int64
+	Name     string
+	Distance float64
+}
+
+func buildTestPdfTable(rows int) *Table[testPdfRow] {
+	builder := NewBuilder[testPdfRow](testContext(), testTranslator)
+	builder.SetTitle("Fahrzeugbericht")
+	builder.IdField("id", "device.id", func(r testPdfRow) int64 { return r.ID })
+	builder.TextField("name", "device.name", func(r testPdfRow) string { return r.Name })
+	builder.DistanceField("distance", "trip.distance", func(r testPdfRow) float64 { return r.Distance }).
+		WithFooterSum()
+	tbl := builder.Build()
+
+	data := make([]testPdfRow, rows)
+	for i := range data {
+		data[i] = testPdfRow{ID: int64(i + 1), Name: fmt.Sprintf("Gerät %d", i+1), Distance: float64(i) * 1.5}
+	}
+	tbl.SetData(data)
+	tbl.SetOutputType(OutputPDF)
+	return tbl
+}
+
+// TestPDFDataResponse verifies OutputPDF produces a PDF DataResult
+func TestPDFDataResponse(t *testing.T) {
+	tbl := buildTestPdfTable(150) // Enough rows for multiple pages
+
+	result := tbl.DataResponse(testTranslator)
+	if result.Type != response.ResponsePDF {
+		t.Fatalf("Expected ResponsePDF, got %v", result.Type)
+	}
+
+	pdf, ok := result.Body.([]byte)
+	if !ok {
+		t.Fatalf("Expected []byte body, got %T", result.Body)
+	}
+	if !bytes.HasPrefix(pdf, []byte("%PDF-")) {
+		t.Errorf("Expected PDF header, got %q", pdf[:min(len(pdf), 8)])
+	}
+	if !strings.HasPrefix(result.Filename, "Fahrzeugbericht_") {
+		t.Errorf("Expected filename from table title, got %q", result.Filename)
+	}
+}
+
+// TestPDFResponseFooterAndFields verifies footer and CSV field selection are set for PDF output
+func TestPDFResponseFooterAndFields(t *testing.T) {
+	tbl := buildTestPdfTable(3)
+
+	td := tbl.ToTableDataResponse()
+	if _, ok := td.footer["distance"]; !ok {
+		t.Error("Expected distance footer for PDF output")
+	}
+	if td.title == nil || *td.title != "Fahrzeugbericht" {
+		t.Errorf("Expected title to be passed to PDF response, got %v", td.title)
+	}
+	// IdField is csv=false by default and must not appear in the report
+	for _, f := range td.fieldsForCSV {
+		if f["id"] == "id" {
+			t.Error("Expected id field to be excluded from PDF columns")
+		}
+	}
+}
+
+// TestPDFEmptyTable verifies an empty table still renders a valid PDF
+func TestPDFEmptyTable(t *testing.T) {
+	tbl := buildTestPdfTable(0)
+
+	printed := tbl.ToTableDataResponse().Print(testTranslator)
+	pdf, ok := printed["pdf"].([]byte)
+	if !ok || !bytes.HasPrefix(pdf, []byte("%PDF-")) {
+		t.Error("Expected valid PDF for empty table")
+	}
+}
+
+// TestPdfCellLines verifies conversion of formatted values into cell lines
+func TestPdfCellLines(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected []string
+	}{
+		{"nil", nil, []string{""}},
+		{"string", "abc", []string{"abc"}},
+		{"text2", [2]string{"a", "b"}, []string{"a", "b"}},
+		{"text2 single", [2]string{"a", ""}, []string{"a"}},
+		{"textN", []string{"a", "b", "c"}, []string{"a", "b", "c"}},
+		{"display value", []any{"1,5 km", 1.5}, []string{"1,5 km"}},
+		{"int", 42, []string{"42"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pdfCellLines(tt.value)
+			if fmt.Sprint(got) != fmt.Sprint(tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestPdfWrapLine verifies that cell text is wrapped to the column width
+func TestPdfWrapLine(t *testing.T) {
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetFont("Helvetica", "", pdfFontSize)
+
+	width := pdf.GetStringWidth("Truck 1234")
+	lines := pdfWrapLine(pdf, "Truck 1234 Vienna Depot North", width)
+	if len(lines) < 3 || lines[0] != "Truck 1234" {
+		t.Errorf("Expected wrapped lines, got %q", lines)
+	}
+	for _, line := range append(lines, pdfWrapLine(pdf, strings.Repeat("X", 40), width)...) {
+		if pdf.GetStringWidth(line) > width {
+			t.Errorf("Line %q exceeds the column width", line)
+		}
+	}
+	if got := pdfWrapLine(pdf, "short", width); len(got) != 1 || got[0] != "short" {
+		t.Errorf("Expected short line unchanged, got %q", got)
+	}
+}
+
+// TestPDFTallRow verifies that a row taller than a page is continued on the next pages
+func TestPDFTallRow(t *testing.T) {
+	tbl := buildTestPdfTable(0)
+	tbl.SetData([]testPdfRow{{ID: 1, Name: strings.Repeat("Lorem ipsum dolor sit amet ", 600), Distance: 1}})
+
+	pdf := tbl.ToTableDataResponse().Print(testTranslator)["pdf"].([]byte)
+	var pages int
+	if i := bytes.Index(pdf, []byte("/Count ")); i >= 0 {
+		fmt.Sscanf(string(pdf[i+len("/Count "):]), "%d", &pages)
+	}
+	if pages < 3 {
+		t.Errorf("Expected the tall row to span several pages, got %d", pages)
+	}
+
+	head, rest := pdfSplitRow([][]string{{"a", "b", "c"}, {"x"}}, 2)
+	if fmt.Sprint(head) != "[[a b] [x]]" || fmt.Sprint(rest) != "[[c] []]" {
+		t.Errorf("Unexpected split %v / %v", head, rest)
+	}
+}
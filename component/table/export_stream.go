@@ -0,0 +1,258 @@
+package table
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// ============================================================================
+// Streaming Export
+// ============================================================================
+//
+// The streaming exports format and write one row at a time instead of
+// materializing all rows via SetData()/GetData(). Memory use stays flat
+// regardless of the number of rows, which makes them suitable for very
+// large exports (hundreds of thousands of rows).
+//
+// Differences to the buffered ToTableDataResponse() export:
+//   - Variable-line fields (TextN, IntN, ...) are joined with " - " into a
+//     single column, because the maximum line count is not known up front.
+//   - Excel column widths are derived from the header only and no
+//     auto-filter is set (typed cells, number formats, frozen header and
+//     footer formulas are the same as in the buffered export).
+
+// streamColumn describes one exported column for streaming output.
+type streamColumn[T any] struct {
+	field *Field[T]
+	name  string // Translated header
+}
+
+// streamColumns returns the CSV/Excel-enabled, non-hidden fields with translated headers.
+func (t *Table[T]) streamColumns() []streamColumn[T] {
+	trans := t.translator
+	if trans == nil {
+		trans = func(key string) string { return key }
+	}
+
+	columns := make([]streamColumn[T], 0, len(t.fields))
+	for _, field := range t.fields {
+		if field.IsHidden() || !field.IsCsvEnabled() {
+			continue
+		}
+		columns = append(columns, streamColumn[T]{field: field, name: translate(trans, field.GetName())})
+	}
+	return columns
+}
+
+// streamRows formats every row of the sequence and yields the cell values in column order.
+func (t *Table[T]) streamRows(rows iter.Seq[T], columns []streamColumn[T], output OutputType) iter.Seq[[]any] {
+	return func(yield func([]any) bool) {
+		fieldMap := t.buildFieldMap()
+		cells := make([]any, len(columns))
+
+		for rowData := range rows {
+			row := NewTypedRow(rowData, fieldMap)
+			for i, col := range columns {
+				value := col.field.GetAccessor()(rowData)
+				if output == OutputCSV {
+					cells[i] = streamCellValue(col.field.formatCSV(value, row, t.csvOptions.Mode, t.ctx))
+				} else {
+					cells[i] = streamCellValue(col.field.Format(value, row, output, t.ctx))
+				}
+			}
+			if !yield(cells) {
+				return
+			}
+		}
+	}
+}
+
+// streamCellValue flattens formatted values into a single cell value.
+func streamCellValue(value any) any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []any:
+		// [display, value] arrays: use the display value
+		if len(v) == 0 {
+			return nil
+		}
+		return v[0]
+	case []string:
+		return strings.Join(v, " - ")
+	case [2]string:
+		if v[1] == "" {
+			return v[0]
+		}
+		return v[0] + " - " + v[1]
+	}
+	return value
+}
+
+// WriteCSV streams the rows as CSV directly to w using the table's CSV formatters,
+// in the dialect of the table's CSVOptions like the buffered CSV export.
+//
+// Example:
+//
+//	rows := dbm.Trip.Iterate(filters) // iter.Seq[TripRow]
+//	c.Response().Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+//	return tbl.WriteCSV(c.Response(), rows)
+func (t *Table[T]) WriteCSV(w io.Writer, rows iter.Seq[T]) error {
+	columns := t.streamColumns()
+
+	writer := newCSVWriter(w, t.csvOptions.resolve(t.ctx))
+
+	header := make([]string, len(columns))
+	for i, col := range columns {
+		header[i] = col.name
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("error writing CSV header: %w", err)
+	}
+
+	record := make([]string, len(columns))
+	for cells := range t.streamRows(rows, columns, OutputCSV) {
+		for i, value := range cells {
+			if value == nil {
+				record[i] = ""
+			} else {
+				record[i] = fmt.Sprintf("%v", value)
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("error writing CSV row: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("error flushing CSV writer: %w", err)
+	}
+	return nil
+}
+
+// WriteExcel streams the rows as an Excel (.xlsx) workbook directly to w
+// using excelize's StreamWriter and the table's Excel formatters (typed cells
+// with number formats, frozen header row and footer formulas).
+//
+// Example:
+//
+//	rows := dbm.Trip.Iterate(filters) // iter.Seq[TripRow]
+//	return tbl.WriteExcel(c.Response(), rows)
+func (t *Table[T]) WriteExcel(w io.Writer, rows iter.Seq[T]) error {
+	columns := t.streamColumns()
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheetName := "Sheet1"
+	sw, err := f.NewStreamWriter(sheetName)
+	if err != nil {
+		return fmt.Errorf("error creating Excel stream writer: %w", err)
+	}
+
+	// Column widths and panes must be set before the first row is written
+	for i, col := range columns {
+		if err := sw.SetColWidth(i+1, i+1, excelColumnWidth(len(col.name))); err != nil {
+			return fmt.Errorf("error setting Excel column width: %w", err)
+		}
+	}
+	panes := excelHeaderPanes
+	if err := sw.SetPanes(&panes); err != nil {
+		return fmt.Errorf("error freezing Excel header row: %w", err)
+	}
+
+	styles := newExcelStyles(f)
+	headerStyle, err := styles.get("", true)
+	if err != nil {
+		return err
+	}
+	header := make([]any, len(columns))
+	cellStyles := make([]int, len(columns))
+	for i, col := range columns {
+		header[i] = excelize.Cell{StyleID: headerStyle, Value: col.name}
+		if numFmt := col.field.excelNumberFormat(); numFmt != "" {
+			if cellStyles[i], err = styles.get(numFmt, false); err != nil {
+				return err
+			}
+		}
+	}
+	if err := sw.SetRow("A1", header); err != nil {
+		return fmt.Errorf("error writing Excel header: %w", err)
+	}
+
+	rowIdx := 2 // Excel rows are 1-indexed, +1 for header
+	values := make([]any, len(columns))
+	for cells := range t.streamRows(rows, columns, OutputExcel) {
+		for i, value := range cells {
+			if value != nil && cellStyles[i] != 0 {
+				value = excelize.Cell{StyleID: cellStyles[i], Value: value}
+			}
+			values[i] = value
+		}
+		cellName, err := excelize.CoordinatesToCellName(1, rowIdx)
+		if err != nil {
+			return fmt.Errorf("error getting cell name for data: %w", err)
+		}
+		if err := sw.SetRow(cellName, values); err != nil {
+			return fmt.Errorf("error writing Excel row: %w", err)
+		}
+		rowIdx++
+	}
+
+	// Footer formulas below the data
+	if err := t.streamExcelFooter(f, sw, styles, columns, rowIdx); err != nil {
+		return err
+	}
+
+	if err := sw.Flush(); err != nil {
+		return fmt.Errorf("error flushing Excel stream writer: %w", err)
+	}
+	if _, err := f.WriteTo(w); err != nil {
+		return fmt.Errorf("error writing Excel file: %w", err)
+	}
+	return nil
+}
+
+// streamExcelFooter writes the footer formulas of the streamed sheet to row rowIdx
+// (the row after the last data row). Nothing is written without data rows.
+func (t *Table[T]) streamExcelFooter(f *excelize.File, sw *excelize.StreamWriter, styles *excelStyles, columns []streamColumn[T], rowIdx int) error {
+	if rowIdx <= 2 {
+		return nil
+	}
+	footer := make([]any, len(columns))
+	hasFooter := false
+	for i, col := range columns {
+		colName, err := excelize.ColumnNumberToName(i + 1)
+		if err != nil {
+			return fmt.Errorf("error getting Excel column name: %w", err)
+		}
+		format := excelFormat{numFmt: col.field.excelNumberFormat(), footer: col.field.GetFooter()}
+		formula := excelFooterFormula(colName, format.footer, rowIdx-1)
+		if formula == "" {
+			continue
+		}
+		style, err := styles.get(excelFooterFormat(format), true)
+		if err != nil {
+			return err
+		}
+		footer[i] = excelize.Cell{StyleID: style, Formula: formula}
+		hasFooter = true
+	}
+	if !hasFooter {
+		return nil
+	}
+
+	if err := calcOnLoad(f); err != nil {
+		return err
+	}
+	if err := sw.SetRow("A"+strconv.Itoa(rowIdx), footer); err != nil {
+		return fmt.Errorf("error writing Excel footer: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package table
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Test row struct
+type testStreamRow struct {
+	ID    int64
+	Name  string
+	Lines []string
+}
+
+func buildTestStreamTable() *Table[testStreamRow] {
+	builder := NewBuilder[testStreamRow](testContext(), testTranslator)
+	builder.IdField("id", "device.id", func(r testStreamRow) int64 { return r.ID })
+	builder.TextField("name", "device.name", func(r testStreamRow) string { return r.Name })
+	builder.TextNField("lines", "device.lines", func(r testStreamRow) []string { return r.Lines })
+	return builder.Build()
+}
+
+var testStreamData = []testStreamRow{
+	{ID: 1, Name: "Truck 1", Lines: []string{"a", "b"}},
+	{ID: 2, Name: "Truck;2", Lines: []string{"c"}},
+}
+
+// TestWriteCSV verifies streaming CSV output uses CSV formatters and field selection
+func TestWriteCSV(t *testing.T) {
+	tbl := buildTestStreamTable()
+
+	var buf bytes.Buffer
+	if err := tbl.WriteCSV(&buf, slices.Values(testStreamData)); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+
+	expected := "Device Name;device.lines\nTruck 1;a - b\n\"Truck;2\";c\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
+
+// TestWriteCSVEmpty verifies an empty sequence produces a header-only CSV
+func TestWriteCSVEmpty(t *testing.T) {
+	tbl := buildTestStreamTable()
+
+	var buf bytes.Buffer
+	if err := tbl.WriteCSV(&buf, slices.Values([]testStreamRow{})); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	if strings.Count(buf.String(), "\n") != 1 {
+		t.Errorf("Expected header row only, got %q", buf.String())
+	}
+}
+
+// TestWriteExcel verifies streaming Excel output can be read back
+func TestWriteExcel(t *testing.T) {
+	tbl := buildTestStreamTable()
+
+	var buf bytes.Buffer
+	if err := tbl.WriteExcel(&buf, slices.Values(testStreamData)); err != nil {
+		t.Fatalf("WriteExcel failed: %v", err)
+	}
+
+	f, err := excelize.OpenReader(&buf)
+	if err != nil {
+		t.Fatalf("Failed to open Excel output: %v", err)
+	}
+	defer f.Close()
+
+	rows, err := f.GetRows("Sheet1")
+	if err != nil {
+		t.Fatalf("Failed to read rows: %v", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("Expected 3 rows (header + 2), got %d", len(rows))
+	}
+	if rows[0][0] != "Device Name" || rows[2][0] != "Truck;2" || rows[1][1] != "a - b" {
+		t.Errorf("Unexpected Excel content: %v", rows)
+	}
+}
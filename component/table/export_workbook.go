@@ -0,0 +1,307 @@
+package table
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/xiriframework/xiri-go/component/core"
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/response"
+	"github.com/xiriframework/xiri-go/uicontext"
+	"github.com/xuri/excelize/v2"
+)
+
+// ============================================================================
+// Multi-Sheet Workbook
+// ============================================================================
+//
+// A Workbook bundles several tables into one Excel file: an optional summary
+// sheet (e.g. the applied filters) followed by one sheet per table. The tables
+// may have different row types; each sheet is written like the single-table
+// Excel export (typed cells, frozen header, auto-filter, footer formulas).
+
+// WorkbookTable is a table that can be added to a Workbook. It is implemented by *Table[T]
+// for any row type T.
+type WorkbookTable interface {
+	excelSheet() excelSheet
+	sheetTitle() string
+}
+
+// excelSheet returns the Excel sheet content of the table (all rows, export columns).
+func (t *Table[T]) excelSheet() excelSheet {
+	return t.toTableDataResponse(OutputExcel).excelSheet()
+}
+
+// sheetTitle returns the translated table title ("" if not set).
+func (t *Table[T]) sheetTitle() string {
+	if t.options.Title == nil {
+		return ""
+	}
+	if t.translator == nil {
+		return *t.options.Title
+	}
+	return translate(t.translator, *t.options.Title)
+}
+
+// SummaryRow is a label/value row of a workbook's summary sheet.
+type SummaryRow struct {
+	Label string // Translated label
+	Value string // Display value
+}
+
+// FilterSummary returns the applied filter values (see LoadFilterData) as summary rows
+// with translated field names. Options of select and model fields are shown by name.
+// Empty values and fields not shown in the filter form are skipped; without active
+// filters the result is nil.
+//
+// Example:
+//
+//	wb.SetSummary("report.filter", tripTable.FilterSummary())
+func (t *Table[T]) FilterSummary() []SummaryRow {
+	if t.filter == nil {
+		return nil
+	}
+	trans := t.translator
+	if trans == nil {
+		trans = func(key string) string { return key }
+	}
+
+	var rows []SummaryRow
+	for _, f := range t.filter.GetFields() {
+		if !f.GetForm() {
+			continue
+		}
+		value := filterDisplayValue(f, t.filterData[f.GetID()], t.ctx, trans)
+		if value == "" {
+			continue
+		}
+		rows = append(rows, SummaryRow{Label: translate(trans, f.GetName()), Value: value})
+	}
+	return rows
+}
+
+// filterDisplayValue returns the display value of a filter value: option names for
+// fields with an option list, multiple values joined with ", ".
+func filterDisplayValue(f field.FormField, value any, ctx *uicontext.UiContext, trans core.TranslateFunc) string {
+	if value == nil {
+		return ""
+	}
+
+	names := make(map[string]string)
+	if list, ok := f.ExportForFrontend(ctx, value)["list"].([]map[string]interface{}); ok {
+		for _, opt := range list {
+			names[fmt.Sprint(opt["id"])] = translate(trans, fmt.Sprint(opt["name"]))
+		}
+	}
+	display := func(v any) string {
+		s := fmt.Sprint(v)
+		if name, ok := names[s]; ok {
+			return name
+		}
+		return s
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = display(item)
+		}
+		return strings.Join(parts, ", ")
+	case []string:
+		parts := make([]string, len(v))
+		for i, item := range v {
+			parts[i] = display(item)
+		}
+		return strings.Join(parts, ", ")
+	}
+	return display(value)
+}
+
+// workbookSheet is a table sheet of a Workbook.
+type workbookSheet struct {
+	name  string // Translation key ("" = table title)
+	table WorkbookTable
+}
+
+// Workbook bundles several tables and an optional summary sheet into one Excel workbook.
+type Workbook struct {
+	ctx         *uicontext.UiContext
+	translator  core.TranslateFunc
+	title       string
+	summaryName string
+	summary     []SummaryRow
+	sheets      []workbookSheet
+}
+
+// NewWorkbook creates an empty workbook. The title (translation key) names the download
+// file (see DataResponse).
+//
+// Example:
+//
+//	wb := table.NewWorkbook(ctx, translator, "report.fleet").
+//	    SetSummary("report.filter", tripTable.FilterSummary()).
+//	    AddTable("report.trips", tripTable).
+//	    AddTable("report.stops", stopTable)
+//	result, err := wb.DataResponse()
+func NewWorkbook(ctx *uicontext.UiContext, translator core.TranslateFunc, title string) *Workbook {
+	if translator == nil {
+		translator = func(key string) string { return key }
+	}
+	return &Workbook{ctx: ctx, translator: translator, title: title}
+}
+
+// AddTable adds a table as sheet. The sheet name is a translation key; if empty, the
+// table title is used. Names are shortened to 31 characters and made unique.
+func (wb *Workbook) AddTable(name string, tbl WorkbookTable) *Workbook {
+	wb.sheets = append(wb.sheets, workbookSheet{name: name, table: tbl})
+	return wb
+}
+
+// SetSummary sets the summary sheet (first sheet) with label/value rows, e.g. the applied
+// filters from Table.FilterSummary. The sheet name is a translation key. No summary
+// sheet is written for empty rows.
+func (wb *Workbook) SetSummary(name string, rows []SummaryRow) *Workbook {
+	wb.summaryName = name
+	wb.summary = rows
+	return wb
+}
+
+// Write writes the workbook as .xlsx file to w.
+func (wb *Workbook) Write(w io.Writer) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	used := make(map[string]bool)
+	addSheet := func(name string) (string, error) {
+		name = excelSheetName(name, len(used)+1, used)
+		if len(used) == 1 {
+			// Rename the default sheet of the new file
+			return name, f.SetSheetName("Sheet1", name)
+		}
+		_, err := f.NewSheet(name)
+		return name, err
+	}
+
+	if len(wb.summary) > 0 {
+		name, err := addSheet(translate(wb.translator, wb.summaryName))
+		if err != nil {
+			return fmt.Errorf("error creating Excel summary sheet: %w", err)
+		}
+		if err := writeSummarySheet(f, name, wb.summary); err != nil {
+			return err
+		}
+	}
+
+	for _, sheet := range wb.sheets {
+		title := sheet.table.sheetTitle()
+		if sheet.name != "" {
+			title = translate(wb.translator, sheet.name)
+		}
+		name, err := addSheet(title)
+		if err != nil {
+			return fmt.Errorf("error creating Excel sheet: %w", err)
+		}
+		if err := writeExcelSheet(f, name, sheet.table.excelSheet()); err != nil {
+			return fmt.Errorf("error writing Excel sheet %q: %w", name, err)
+		}
+	}
+	f.SetActiveSheet(0)
+
+	if _, err := f.WriteTo(w); err != nil {
+		return fmt.Errorf("error writing Excel file: %w", err)
+	}
+	return nil
+}
+
+// Bytes returns the workbook as .xlsx file content.
+func (wb *Workbook) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := wb.Write(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// DataResponse returns the workbook as Excel DataResult, named after the workbook title
+// and the current time in the user's timezone (see response.ExportFilename).
+func (wb *Workbook) DataResponse() (response.DataResult, error) {
+	data, err := wb.Bytes()
+	if err != nil {
+		return response.DataResult{}, err
+	}
+	result := response.NewExcelDataResult(data)
+	result.Filename = response.ExportFilename(wb.ctx, translate(wb.translator, wb.title), time.Now())
+	return result, nil
+}
+
+// writeSummarySheet writes label/value rows (labels bold) to an existing worksheet.
+func writeSummarySheet(f *excelize.File, sheetName string, rows []SummaryRow) error {
+	styles := newExcelStyles(f)
+	labelStyle, err := styles.get("", true)
+	if err != nil {
+		return err
+	}
+
+	labelLength, valueLength := 0, 0
+	for i, row := range rows {
+		rowNum := strconv.Itoa(i + 1)
+		if err := f.SetCellValue(sheetName, "A"+rowNum, row.Label); err != nil {
+			return fmt.Errorf("error writing summary cell: %w", err)
+		}
+		if err := f.SetCellStyle(sheetName, "A"+rowNum, "A"+rowNum, labelStyle); err != nil {
+			return fmt.Errorf("error setting summary style: %w", err)
+		}
+		if err := f.SetCellValue(sheetName, "B"+rowNum, row.Value); err != nil {
+			return fmt.Errorf("error writing summary cell: %w", err)
+		}
+		labelLength = max(labelLength, utf8.RuneCountInString(row.Label))
+		valueLength = max(valueLength, utf8.RuneCountInString(row.Value))
+	}
+
+	if err := f.SetColWidth(sheetName, "A", "A", excelColumnWidth(labelLength)); err != nil {
+		return fmt.Errorf("error setting summary column width: %w", err)
+	}
+	if err := f.SetColWidth(sheetName, "B", "B", excelColumnWidth(valueLength)); err != nil {
+		return fmt.Errorf("error setting summary column width: %w", err)
+	}
+	return nil
+}
+
+// excelSheetName returns a valid, unique sheet name and marks it as used: characters
+// not allowed by Excel ([ ] : * ? / \) are replaced with "_", names are limited to
+// 31 characters and duplicates get a " (2)" suffix. An empty name becomes "Sheet<index>".
+func excelSheetName(name string, index int, used map[string]bool) string {
+	name = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`[]:*?/\`, r) {
+			return '_'
+		}
+		return r
+	}, name)
+	name = strings.Trim(strings.TrimSpace(name), "'")
+	if name == "" {
+		name = "Sheet" + strconv.Itoa(index)
+	}
+
+	base := truncateRunes(name, 31)
+	name = base
+	for n := 2; used[strings.ToLower(name)]; n++ {
+		suffix := " (" + strconv.Itoa(n) + ")"
+		name = truncateRunes(base, 31-len(suffix)) + suffix
+	}
+	used[strings.ToLower(name)] = true
+	return name
+}
+
+// truncateRunes shortens s to at most n characters.
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
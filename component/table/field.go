@@ -105,7 +105,18 @@ func (f *Field[T]) GetFormatter(output OutputType) OutputFormatter {
 //
 // CRITICAL: For number-type fields on web output, this wraps the result in [display, value] array
 // to maintain exact JSON compatibility with xiri-ui frontend expectations.
+//
+// Outputs without a formatter of their own fall back: OutputJSONL returns the raw value,
+// OutputODS uses the Excel formatter and OutputHTML the PDF formatter.
 func (f *Field[T]) Format(value any, row Row, output OutputType, ctx *uicontext.UiContext) any {
+	if _, ok := f.formatters[output]; !ok {
+		if output == OutputJSONL {
+			return value
+		}
+		if fallback, ok := outputFallbacks[output]; ok {
+			output = fallback
+		}
+	}
 	formatter := f.GetFormatter(output)
 	formatted := formatter.Format(value, row, output, ctx)
 
@@ -13,7 +13,7 @@ type OutputFormatter interface {
 	// Parameters:
 	//   value: The field's own value (from the accessor function)
 	//   row: Access to all other field values in the row (for cross-field dependencies)
-	//   output: Target output type (Web, CSV, PDF, Excel)
+	//   output: Target output type (Web, CSV, PDF, Excel, JSONL, ODS, HTML)
 	//   ctx: User context with locale/language/timezone/unit preferences
 	//
 	// Returns:
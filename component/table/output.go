@@ -15,6 +15,18 @@ const (
 
 	// OutputExcel is for Excel (XLSX) export
 	OutputExcel
+
+	// OutputJSONL is for JSON Lines export (one JSON object per row, keyed by field ID).
+	// Fields without a JSONL formatter export their raw accessor values.
+	OutputJSONL
+
+	// OutputODS is for OpenDocument spreadsheet (LibreOffice) export.
+	// Fields without an ODS formatter use their Excel formatter.
+	OutputODS
+
+	// OutputHTML is for self-contained HTML tables with inline styles (e.g. email reports).
+	// Fields without an HTML formatter use their PDF formatter.
+	OutputHTML
 )
 
 // String returns the string representation of the output type
@@ -28,7 +40,29 @@ func (o OutputType) String() string {
 		return "pdf"
 	case OutputExcel:
 		return "excel"
+	case OutputJSONL:
+		return "jsonl"
+	case OutputODS:
+		return "ods"
+	case OutputHTML:
+		return "html"
 	default:
 		return "unknown"
 	}
 }
+
+// outputFallbacks maps output types to the output type whose formatters they use
+// if a field has no formatter for them (see Field.Format).
+var outputFallbacks = map[OutputType]OutputType{
+	OutputODS:  OutputExcel,
+	OutputHTML: OutputPDF,
+}
+
+// isExport returns true for file exports, which only contain CSV-enabled fields
+// (see WithCsv). PDF reports select their columns separately.
+func (o OutputType) isExport() bool {
+	return o != OutputWeb && o != OutputPDF
+}
+
+// outputRequestParams are the request flags selecting the output type (see LoadFilterData).
+var outputRequestParams = []string{"_csv", "_excel", "_pdf", "_jsonl", "_ods", "_html"}
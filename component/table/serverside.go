@@ -0,0 +1,236 @@
+package table
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ============================================================================
+// In-Memory Server-Side Mode
+// ============================================================================
+//
+// In this mode the table applies the server-side pagination parameters
+// (_page, _pageSize, _sort, _sortDir, _search) to the rows passed to SetData().
+// Handlers only load the full (filtered) dataset; searching, sorting, paging
+// and totalCount are handled by ToTableDataResponse().
+//
+// Example:
+//
+//	builder.SetServerSideInMemory()
+//	tbl := builder.Build()
+//
+//	filters, err := tbl.LoadFilterData(echoadapter.Source(c))
+//	if err != nil {
+//	    return wc.BadRequest(err.Error())
+//	}
+//	tbl.SetData(dbm.Device.FindAll(filters))
+//	return wc.TableData(tbl.ToTableDataResponse())
+
+// applyServerSide searches and sorts the table data according to params.
+// Returns the matching rows (used for footer and exports) and the rows of the requested page.
+func (t *Table[T]) applyServerSide(params PaginationParams) (matched []T, page []T) {
+	matched = t.searchRows(t.data, params.Search)
+	matched = t.sortRows(matched, params.Sort, params.SortDir)
+
+	if params.PageSize <= 0 {
+		return matched, matched
+	}
+	start := params.Page * params.PageSize
+	if start < 0 || start >= len(matched) {
+		return matched, []T{}
+	}
+	end := min(start+params.PageSize, len(matched))
+	return matched, matched[start:end]
+}
+
+// searchRows returns the rows where any searchable, visible field contains the search text.
+// Matching is case-insensitive and uses the web-formatted value (what the user sees).
+func (t *Table[T]) searchRows(data []T, search string) []T {
+	search = strings.ToLower(strings.TrimSpace(search))
+	if search == "" {
+		return data
+	}
+
+	searchFields := make([]*Field[T], 0, len(t.fields))
+	for _, field := range t.fields {
+		if field.GetSearch() && !field.IsHidden() {
+			searchFields = append(searchFields, field)
+		}
+	}
+
+	fieldMap := t.buildFieldMap()
+	result := make([]T, 0)
+	for _, rowData := range data {
+		row := NewTypedRow(rowData, fieldMap)
+		for _, field := range searchFields {
+			formatted := field.Format(field.GetAccessor()(rowData), row, OutputWeb, t.ctx)
+			if strings.Contains(strings.ToLower(searchText(formatted)), search) {
+				result = append(result, rowData)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// searchText converts a web-formatted value into plain text for searching.
+func searchText(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []any:
+		// [display, value] arrays: search the display value
+		if len(v) == 0 {
+			return ""
+		}
+		return fmt.Sprint(v[0])
+	case [2]string:
+		return v[0] + " " + v[1]
+	case []string:
+		return strings.Join(v, " ")
+	}
+	return fmt.Sprint(value)
+}
+
+// sortRows sorts the rows by the raw accessor value of the given field.
+// Unknown or non-sortable fields leave the order unchanged. The sort is stable.
+func (t *Table[T]) sortRows(data []T, fieldID string, sortDir string) []T {
+	if fieldID == "" {
+		return data
+	}
+
+	var sortField *Field[T]
+	for _, field := range t.fields {
+		if field.GetID() == fieldID && field.GetSort() {
+			sortField = field
+			break
+		}
+	}
+	if sortField == nil {
+		return data
+	}
+
+	accessor := sortField.GetAccessor()
+	sorted := slices.Clone(data)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		c := compareValues(accessor(a), accessor(b))
+		if sortDir == "desc" {
+			return -c
+		}
+		return c
+	})
+	return sorted
+}
+
+// compareValues compares two raw accessor values with type-appropriate semantics.
+// Numbers compare numerically, strings case-insensitively, false sorts before true,
+// and multi-value fields ([2]T, []T) compare by their first element.
+func compareValues(a, b any) int {
+	a = sortKey(a)
+	b = sortKey(b)
+
+	switch av := a.(type) {
+	case nil:
+		if b == nil {
+			return 0
+		}
+		return -1
+	case string:
+		if bv, ok := b.(string); ok {
+			return cmp.Compare(strings.ToLower(av), strings.ToLower(bv))
+		}
+	case bool:
+		if bv, ok := b.(bool); ok {
+			switch {
+			case av == bv:
+				return 0
+			case !av:
+				return -1
+			default:
+				return 1
+			}
+		}
+	case time.Time:
+		if bv, ok := b.(time.Time); ok {
+			return av.Compare(bv)
+		}
+	case int64:
+		if bv, ok := b.(int64); ok {
+			return cmp.Compare(av, bv)
+		}
+	case float64:
+		if bv, ok := b.(float64); ok {
+			return cmp.Compare(av, bv)
+		}
+	}
+
+	if b == nil {
+		return 1
+	}
+	return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+// sortKey normalizes a raw value for comparison: integers to int64, floats to float64,
+// and multi-value fields to their first element.
+func sortKey(value any) any {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case int32:
+		return int64(v)
+	case int64:
+		return v
+	case float32:
+		return float64(v)
+	case float64:
+		return v
+	case [2]string:
+		return v[0]
+	case [2]int:
+		return int64(v[0])
+	case [2]int64:
+		return v[0]
+	case [2]float64:
+		return v[0]
+	case [2]bool:
+		return v[0]
+	case [2]time.Time:
+		return v[0]
+	case []string:
+		if len(v) > 0 {
+			return v[0]
+		}
+		return nil
+	case []int:
+		if len(v) > 0 {
+			return int64(v[0])
+		}
+		return nil
+	case []int64:
+		if len(v) > 0 {
+			return v[0]
+		}
+		return nil
+	case []float64:
+		if len(v) > 0 {
+			return v[0]
+		}
+		return nil
+	case []bool:
+		if len(v) > 0 {
+			return v[0]
+		}
+		return nil
+	case []time.Time:
+		if len(v) > 0 {
+			return v[0]
+		}
+		return nil
+	}
+	return value
+}
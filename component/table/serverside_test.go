@@ -0,0 +1,127 @@
+package table
+
+import (
+	"testing"
+)
+
+// Test row struct
+type testServerSideRow struct {
+	ID       int64
+	Name     string
+	Distance float64
+	Active   bool
+}
+
+func buildTestServerSideTable(filterData map[string]any) *Table[testServerSideRow] {
+	builder := NewBuilder[testServerSideRow](testContext(), testTranslator)
+	builder.SetServerSideInMemory()
+	builder.IdField("id", "device.id", func(r testServerSideRow) int64 { return r.ID })
+	builder.TextField("name", "device.name", func(r testServerSideRow) string { return r.Name })
+	builder.DistanceField("distance", "trip.distance", func(r testServerSideRow) float64 { return r.Distance }).
+		WithSearch(false).
+		WithFooterSum()
+	builder.BoolField("active", "device.active", func(r testServerSideRow) bool { return r.Active })
+	tbl := builder.Build()
+	tbl.SetData([]testServerSideRow{
+		{ID: 1, Name: "beta", Distance: 10, Active: true},
+		{ID: 2, Name: "Alpha", Distance: 2.5, Active: false},
+		{ID: 3, Name: "gamma", Distance: 100, Active: true},
+		{ID: 4, Name: "Alphorn", Distance: 7, Active: false},
+	})
+	tbl.SetFilterData(filterData)
+	return tbl
+}
+
+func responseIDs(td *TableDataResponse) []int64 {
+	ids := make([]int64, len(td.data))
+	for i, row := range td.data {
+		ids[i] = row["id"].(int64)
+	}
+	return ids
+}
+
+// TestServerSideInMemorySortAndPage verifies sorting by raw values and paging
+func TestServerSideInMemorySortAndPage(t *testing.T) {
+	tbl := buildTestServerSideTable(map[string]any{
+		"_page":     float64(1),
+		"_pageSize": float64(2),
+		"_sort":     "distance",
+		"_sortDir":  "desc",
+	})
+
+	td := tbl.ToServerSideResponse(999)
+	ids := responseIDs(td)
+	// distance desc: 3 (100), 1 (10), 4 (7), 2 (2.5) -> page 1 = [4, 2]
+	if len(ids) != 2 || ids[0] != 4 || ids[1] != 2 {
+		t.Errorf("Expected page [4 2], got %v", ids)
+	}
+	if td.totalCount == nil || *td.totalCount != 4 {
+		t.Errorf("Expected automatic totalCount 4, got %v", td.totalCount)
+	}
+}
+
+// TestServerSideInMemoryStringSort verifies case-insensitive string sorting
+func TestServerSideInMemoryStringSort(t *testing.T) {
+	tbl := buildTestServerSideTable(map[string]any{"_sort": "name"})
+
+	ids := responseIDs(tbl.ToTableDataResponse())
+	expected := []int64{2, 4, 1, 3} // Alpha, Alphorn, beta, gamma
+	for i := range expected {
+		if ids[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, ids)
+		}
+	}
+}
+
+// TestServerSideInMemorySearch verifies search on searchable formatted values only
+func TestServerSideInMemorySearch(t *testing.T) {
+	tbl := buildTestServerSideTable(map[string]any{"_search": "ALPH"})
+
+	td := tbl.ToTableDataResponse()
+	if len(td.data) != 2 || *td.totalCount != 2 {
+		t.Errorf("Expected 2 matches, got %d (total %v)", len(td.data), td.totalCount)
+	}
+	// Footer is computed over all matching rows
+	if td.footer["distance"] == nil {
+		t.Error("Expected distance footer")
+	}
+
+	// Distance has WithSearch(false) and must not match
+	tbl = buildTestServerSideTable(map[string]any{"_search": "100"})
+	if td := tbl.ToTableDataResponse(); len(td.data) != 0 {
+		t.Errorf("Expected no matches on non-searchable field, got %d", len(td.data))
+	}
+}
+
+// TestServerSideInMemoryExportIgnoresPaging verifies CSV exports contain all matching rows
+func TestServerSideInMemoryExportIgnoresPaging(t *testing.T) {
+	tbl := buildTestServerSideTable(map[string]any{"_pageSize": float64(1)})
+	tbl.SetOutputType(OutputCSV)
+
+	td := tbl.ToTableDataResponse()
+	if len(td.data) != 4 {
+		t.Errorf("Expected all 4 rows in CSV export, got %d", len(td.data))
+	}
+}
+
+// TestCompareValues verifies type-aware comparison of raw values
+func TestCompareValues(t *testing.T) {
+	tests := []struct {
+		a, b     any
+		expected int
+	}{
+		{int64(2), int64(10), -1},
+		{2.5, 2.5, 0},
+		{"b", "A", 1},
+		{false, true, -1},
+		{nil, "a", -1},
+		{[2]string{"a", "z"}, [2]string{"b", "a"}, -1},
+		{int32(5), int(3), 1},
+	}
+
+	for _, tt := range tests {
+		if got := compareValues(tt.a, tt.b); got != tt.expected {
+			t.Errorf("compareValues(%v, %v) = %d, expected %d", tt.a, tt.b, got, tt.expected)
+		}
+	}
+}
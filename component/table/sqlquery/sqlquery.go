@@ -0,0 +1,379 @@
+// Package sqlquery translates table pagination parameters and parsed filter values
+// into parameterized SQL clauses.
+//
+// Only whitelisted field IDs are accepted for sorting, searching and filtering.
+// Column expressions are provided by the developer and never taken from the request,
+// so the _sort parameter cannot be used for SQL injection.
+//
+// Example:
+//
+//	q := sqlquery.ForTable(tbl, sqlquery.Postgres, map[string]string{
+//	    "name":     "d.name",
+//	    "distance": "t.distance_km",
+//	}).
+//	    Filter("period", "t.started_at", sqlquery.FilterRange).
+//	    Filter("devices", "t.device_id", sqlquery.FilterIn).
+//	    DefaultSort("name", "asc")
+//
+//	filters, err := tbl.LoadFilterData(echoadapter.Source(c))
+//	...
+//	res, err := q.Build(tbl.LoadPaginationParams(), filters)
+//	if err != nil {
+//	    return wc.BadRequest(err.Error())
+//	}
+//	rows, _ := db.Query(res.SQL("SELECT ... FROM trips t JOIN devices d ON ..."), res.Args...)
+//	db.QueryRow("SELECT count(*) FROM trips t JOIN devices d ON ... "+res.WhereClause(), res.WhereArgs...)
+package sqlquery
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xiriframework/xiri-go/component/table"
+	"github.com/xiriframework/xiri-go/form/field"
+)
+
+// Dialect selects placeholder style and case-insensitive LIKE operator.
+type Dialect int
+
+const (
+	// Postgres uses $1, $2, ... placeholders and ILIKE
+	Postgres Dialect = iota
+
+	// MySQL uses ? placeholders and LIKE (case-insensitive with default collations)
+	MySQL
+
+	// SQLite uses ? placeholders and LIKE (case-insensitive for ASCII)
+	SQLite
+)
+
+// FilterOperator defines how a filter value is compared against its column.
+type FilterOperator string
+
+const (
+	FilterEquals       FilterOperator = "="
+	FilterNotEquals    FilterOperator = "<>"
+	FilterGreater      FilterOperator = ">"
+	FilterGreaterEqual FilterOperator = ">="
+	FilterLess         FilterOperator = "<"
+	FilterLessEqual    FilterOperator = "<="
+	FilterIn           FilterOperator = "in"       // Value is a list (e.g., ModelListValue)
+	FilterContains     FilterOperator = "contains" // Case-insensitive substring match
+	FilterRange        FilterOperator = "range"    // Value is *field.TimeRangeValue (start <= col <= end)
+)
+
+// ErrUnknownSortColumn is returned when the requested sort field is not whitelisted.
+var ErrUnknownSortColumn = errors.New("unknown sort column")
+
+// likeEscape is the escape character used in LIKE patterns (portable across dialects).
+const likeEscape = "!"
+
+// filterDef maps a filter field ID to a column expression and operator.
+type filterDef struct {
+	id   string
+	expr string
+	op   FilterOperator
+}
+
+// searchDef maps a searchable field ID to a column expression.
+type searchDef struct {
+	id   string
+	expr string
+}
+
+// Builder holds the whitelist of sortable, searchable and filterable columns.
+// A Builder can be defined once and reused for many requests.
+type Builder struct {
+	dialect        Dialect
+	sortColumns    map[string]string
+	searchColumns  []searchDef
+	filters        []filterDef
+	defaultSort    string
+	defaultSortDir string
+}
+
+// Result contains the generated SQL fragments and their arguments.
+// Placeholders are numbered so that WhereArgs come first, followed by the LIMIT/OFFSET arguments.
+type Result struct {
+	Where     string // Conditions joined with AND, without the WHERE keyword ("" if none)
+	OrderBy   string // "ORDER BY ..." ("" if no sort)
+	Limit     string // "LIMIT ? OFFSET ?" ("" if no page size)
+	WhereArgs []any  // Arguments for Where only (use for count queries)
+	Args      []any  // All arguments (Where + Limit)
+}
+
+// New creates an empty Builder for the given dialect.
+func New(dialect Dialect) *Builder {
+	return &Builder{
+		dialect:     dialect,
+		sortColumns: make(map[string]string),
+	}
+}
+
+// ForTable creates a Builder from a table's field definitions.
+// Each field ID in columns is mapped to its column expression. Fields are sortable
+// if the table field has sort enabled and searchable if it has search enabled and is visible.
+// Field IDs that do not exist in the table are ignored.
+func ForTable[T any](tbl *table.Table[T], dialect Dialect, columns map[string]string) *Builder {
+	b := New(dialect)
+	for _, f := range tbl.GetFields() {
+		expr, ok := columns[f.GetID()]
+		if !ok {
+			continue
+		}
+		if f.GetSort() {
+			b.SortColumn(f.GetID(), expr)
+		}
+		if f.GetSearch() && !f.IsHidden() {
+			b.SearchColumn(f.GetID(), expr)
+		}
+	}
+	return b
+}
+
+// Column registers a column that is both sortable and searchable.
+func (b *Builder) Column(fieldID, expr string) *Builder {
+	b.SortColumn(fieldID, expr)
+	b.SearchColumn(fieldID, expr)
+	return b
+}
+
+// SortColumn registers a sortable column.
+func (b *Builder) SortColumn(fieldID, expr string) *Builder {
+	b.sortColumns[fieldID] = expr
+	return b
+}
+
+// SearchColumn registers a column included in the _search text match.
+func (b *Builder) SearchColumn(fieldID, expr string) *Builder {
+	for i, s := range b.searchColumns {
+		if s.id == fieldID {
+			b.searchColumns[i].expr = expr
+			return b
+		}
+	}
+	b.searchColumns = append(b.searchColumns, searchDef{id: fieldID, expr: expr})
+	return b
+}
+
+// DefaultSort sets the sort used when the request does not specify one.
+// The field must be registered as sort column.
+func (b *Builder) DefaultSort(fieldID, dir string) *Builder {
+	b.defaultSort = fieldID
+	b.defaultSortDir = dir
+	return b
+}
+
+// Filter maps a parsed filter value (from FormGroup.ParseAndValidate) to a WHERE condition.
+// Missing, nil and empty values are skipped.
+func (b *Builder) Filter(filterID, expr string, op FilterOperator) *Builder {
+	b.filters = append(b.filters, filterDef{id: filterID, expr: expr, op: op})
+	return b
+}
+
+// Build generates the SQL fragments for the given pagination params and parsed filter values.
+// Returns ErrUnknownSortColumn (wrapped) if params.Sort is not a whitelisted sort column.
+func (b *Builder) Build(params table.PaginationParams, filters map[string]any) (*Result, error) {
+	q := &queryState{dialect: b.dialect}
+	conditions := make([]string, 0)
+
+	// Filters
+	for _, f := range b.filters {
+		cond, err := q.filterCondition(f, filters[f.id])
+		if err != nil {
+			return nil, err
+		}
+		if cond != "" {
+			conditions = append(conditions, cond)
+		}
+	}
+
+	// Search
+	search := strings.TrimSpace(params.Search)
+	if search != "" && len(b.searchColumns) > 0 {
+		pattern := "%" + escapeLike(search) + "%"
+		placeholder := ""
+		parts := make([]string, len(b.searchColumns))
+		for i, s := range b.searchColumns {
+			// Postgres can reference $n repeatedly, ? placeholders need one argument per use
+			if placeholder == "" || b.dialect != Postgres {
+				placeholder = q.arg(pattern)
+			}
+			parts[i] = q.like(s.expr, placeholder)
+		}
+		conditions = append(conditions, "("+strings.Join(parts, " OR ")+")")
+	}
+
+	res := &Result{
+		Where:     strings.Join(conditions, " AND "),
+		WhereArgs: append([]any(nil), q.args...),
+	}
+
+	// Sort
+	sortID, sortDir := params.Sort, params.SortDir
+	if sortID == "" {
+		sortID, sortDir = b.defaultSort, b.defaultSortDir
+	}
+	if sortID != "" {
+		expr, ok := b.sortColumns[sortID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownSortColumn, sortID)
+		}
+		dir := "ASC"
+		if strings.EqualFold(sortDir, "desc") {
+			dir = "DESC"
+		}
+		res.OrderBy = "ORDER BY " + expr + " " + dir
+	}
+
+	// Pagination
+	if params.PageSize > 0 {
+		page := max(params.Page, 0)
+		limit := q.arg(params.PageSize)
+		offset := q.arg(page * params.PageSize)
+		res.Limit = "LIMIT " + limit + " OFFSET " + offset
+	}
+
+	res.Args = q.args
+	return res, nil
+}
+
+// WhereClause returns "WHERE <conditions>" or an empty string if there are no conditions.
+func (r *Result) WhereClause() string {
+	if r.Where == "" {
+		return ""
+	}
+	return "WHERE " + r.Where
+}
+
+// SQL appends WHERE, ORDER BY and LIMIT clauses to the base query.
+func (r *Result) SQL(base string) string {
+	parts := []string{base}
+	for _, clause := range []string{r.WhereClause(), r.OrderBy, r.Limit} {
+		if clause != "" {
+			parts = append(parts, clause)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// queryState collects arguments and generates placeholders while building a query.
+type queryState struct {
+	dialect Dialect
+	args    []any
+}
+
+// arg adds an argument and returns its placeholder.
+func (q *queryState) arg(value any) string {
+	q.args = append(q.args, value)
+	if q.dialect == Postgres {
+		return "$" + strconv.Itoa(len(q.args))
+	}
+	return "?"
+}
+
+// like returns a case-insensitive LIKE condition for the dialect.
+func (q *queryState) like(expr, placeholder string) string {
+	if q.dialect == Postgres {
+		return expr + " ILIKE " + placeholder + " ESCAPE '" + likeEscape + "'"
+	}
+	return expr + " LIKE " + placeholder + " ESCAPE '" + likeEscape + "'"
+}
+
+// filterCondition converts a single filter value into a WHERE condition.
+// Pointer values (e.g. *int32 of optional fields) are dereferenced; a typed nil
+// pointer means the filter is not set.
+func (q *queryState) filterCondition(f filterDef, value any) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return "", nil
+		}
+		if f.op != FilterRange {
+			value = rv.Elem().Interface()
+		}
+	}
+
+	switch f.op {
+	case FilterRange:
+		tr, ok := value.(*field.TimeRangeValue)
+		if !ok {
+			return "", fmt.Errorf("filter %s: expected time range, got %T", f.id, value)
+		}
+		if tr == nil {
+			return "", nil
+		}
+		return f.expr + " >= " + q.arg(tr.Start) + " AND " + f.expr + " <= " + q.arg(tr.End), nil
+
+	case FilterIn:
+		list, err := toList(value)
+		if err != nil {
+			return "", fmt.Errorf("filter %s: %w", f.id, err)
+		}
+		if len(list) == 0 {
+			return "", nil
+		}
+		placeholders := make([]string, len(list))
+		for i, v := range list {
+			placeholders[i] = q.arg(v)
+		}
+		return f.expr + " IN (" + strings.Join(placeholders, ", ") + ")", nil
+
+	case FilterContains:
+		s := strings.TrimSpace(fmt.Sprint(value))
+		if s == "" {
+			return "", nil
+		}
+		return q.like(f.expr, q.arg("%"+escapeLike(s)+"%")), nil
+
+	case FilterEquals, FilterNotEquals, FilterGreater, FilterGreaterEqual, FilterLess, FilterLessEqual:
+		if s, ok := value.(string); ok && s == "" {
+			return "", nil
+		}
+		if t, ok := value.(time.Time); ok && t.IsZero() {
+			return "", nil
+		}
+		return f.expr + " " + string(f.op) + " " + q.arg(value), nil
+	}
+
+	return "", fmt.Errorf("filter %s: unsupported operator %s", f.id, f.op)
+}
+
+// toList converts list-like filter values into a slice of arguments.
+func toList(value any) ([]any, error) {
+	switch v := value.(type) {
+	case field.ModelListValue:
+		return toAnySlice(v), nil
+	case []int32:
+		return toAnySlice(v), nil
+	case []int:
+		return toAnySlice(v), nil
+	case []int64:
+		return toAnySlice(v), nil
+	case []string:
+		return toAnySlice(v), nil
+	case []any:
+		return v, nil
+	}
+	return nil, fmt.Errorf("expected list, got %T", value)
+}
+
+// toAnySlice converts a typed slice into []any.
+func toAnySlice[E any](values []E) []any {
+	result := make([]any, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+	return result
+}
+
+// escapeLike escapes LIKE wildcards so user input is matched literally.
+func escapeLike(s string) string {
+	return strings.NewReplacer(likeEscape, likeEscape+likeEscape, "%", likeEscape+"%", "_", likeEscape+"_").Replace(s)
+}
@@ -0,0 +1,137 @@
+package sqlquery
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/xiriframework/xiri-go/component/table"
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+type testRow struct {
+	ID   int64
+	Name string
+	City string
+}
+
+func testTable() *table.Table[testRow] {
+	translator := func(key string) string { return key }
+	builder := table.NewBuilder[testRow](&uicontext.UiContext{}, translator)
+	builder.IdField("id", "device.id", func(r testRow) int64 { return r.ID })
+	builder.TextField("name", "device.name", func(r testRow) string { return r.Name })
+	builder.TextField("city", "device.city", func(r testRow) string { return r.City }).WithSort(false)
+	return builder.Build()
+}
+
+func TestBuildPostgres(t *testing.T) {
+	q := ForTable(testTable(), Postgres, map[string]string{
+		"name": "d.name",
+		"city": "d.city",
+	}).Filter("devices", "d.id", FilterIn)
+
+	res, err := q.Build(table.PaginationParams{
+		Page:     2,
+		PageSize: 25,
+		Sort:     "name",
+		SortDir:  "desc",
+		Search:   "50%",
+	}, map[string]any{"devices": field.ModelListValue{4, 7}})
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	expectedWhere := "d.id IN ($1, $2) AND (d.name ILIKE $3 ESCAPE '!' OR d.city ILIKE $3 ESCAPE '!')"
+	if res.Where != expectedWhere {
+		t.Errorf("Expected where %q, got %q", expectedWhere, res.Where)
+	}
+	if res.OrderBy != "ORDER BY d.name DESC" {
+		t.Errorf("Unexpected order by %q", res.OrderBy)
+	}
+	if res.Limit != "LIMIT $4 OFFSET $5" {
+		t.Errorf("Unexpected limit %q", res.Limit)
+	}
+	expectedArgs := []any{int32(4), int32(7), "%50!%%", 25, 50}
+	if !reflect.DeepEqual(res.Args, expectedArgs) {
+		t.Errorf("Expected args %v, got %v", expectedArgs, res.Args)
+	}
+	if len(res.WhereArgs) != 3 {
+		t.Errorf("Expected 3 where args, got %v", res.WhereArgs)
+	}
+}
+
+func TestBuildMySQLSearchArgs(t *testing.T) {
+	q := New(MySQL).Column("name", "name").SearchColumn("city", "city")
+
+	res, err := q.Build(table.PaginationParams{Search: "wien"}, nil)
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if res.Where != "(name LIKE ? ESCAPE '!' OR city LIKE ? ESCAPE '!')" {
+		t.Errorf("Unexpected where %q", res.Where)
+	}
+	if len(res.Args) != 2 {
+		t.Errorf("Expected one argument per LIKE, got %v", res.Args)
+	}
+}
+
+func TestBuildRejectsUnknownSort(t *testing.T) {
+	// city has sort disabled in the table, id is not mapped
+	q := ForTable(testTable(), Postgres, map[string]string{"name": "name", "city": "city"})
+
+	for _, sort := range []string{"city", "id", "name; DROP TABLE x"} {
+		_, err := q.Build(table.PaginationParams{Sort: sort}, nil)
+		if !errors.Is(err, ErrUnknownSortColumn) {
+			t.Errorf("Expected ErrUnknownSortColumn for %q, got %v", sort, err)
+		}
+	}
+}
+
+func TestBuildFilters(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, 0)
+
+	q := New(Postgres).
+		DefaultSort("name", "asc").
+		SortColumn("name", "name").
+		Filter("period", "started_at", FilterRange).
+		Filter("active", "active", FilterEquals).
+		Filter("note", "note", FilterContains).
+		Filter("empty", "empty", FilterIn).
+		Filter("device", "device_id", FilterEquals).
+		Filter("group", "group_id", FilterEquals).
+		Filter("since", "since", FilterRange)
+
+	group := int32(7)
+	res, err := q.Build(table.PaginationParams{}, map[string]any{
+		"period": &field.TimeRangeValue{Start: start, End: end},
+		"active": true,
+		"note":   "",
+		"empty":  field.ModelListValue{},
+		"device": (*int32)(nil),
+		"group":  &group,
+		"since":  (*field.TimeRangeValue)(nil),
+	})
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	expected := "started_at >= $1 AND started_at <= $2 AND active = $3 AND group_id = $4"
+	if res.Where != expected {
+		t.Errorf("Expected where %q, got %q", expected, res.Where)
+	}
+	if len(res.Args) != 4 || res.Args[3] != int32(7) {
+		t.Errorf("Expected dereferenced group argument, got %v", res.Args)
+	}
+	if res.OrderBy != "ORDER BY name ASC" {
+		t.Errorf("Expected default sort, got %q", res.OrderBy)
+	}
+	if res.Limit != "" {
+		t.Errorf("Expected no limit without page size, got %q", res.Limit)
+	}
+	if got := res.SQL("SELECT * FROM trips"); got != "SELECT * FROM trips WHERE "+expected+" ORDER BY name ASC" {
+		t.Errorf("Unexpected SQL %q", got)
+	}
+}
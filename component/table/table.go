@@ -2,15 +2,16 @@ package table
 
 import (
 	"log/slog"
+	"slices"
 	"strconv"
 
-	"github.com/labstack/echo/v4"
 	"github.com/xiriframework/xiri-go/component/button"
 	"github.com/xiriframework/xiri-go/component/core"
 	"github.com/xiriframework/xiri-go/component/emptystate"
 	"github.com/xiriframework/xiri-go/component/query"
 	xurl "github.com/xiriframework/xiri-go/component/url"
 	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/request"
 	"github.com/xiriframework/xiri-go/uicontext"
 )
 
@@ -30,8 +31,11 @@ type Table[T any] struct {
 	flags      []string       // UI-only filter fields (excluded from parsed data)
 	hasFilter  *bool          // Explicit hasFilter override (nil = use t.filter != nil)
 	options    TableOptions
-	outputType OutputType       // Current output mode (Web, CSV, PDF, Excel)
-	components []core.Component // Additional components (charts, stats, progress bars, etc.)
+	outputType OutputType // Current output mode (Web, CSV, PDF, Excel)
+	csvOptions CSVOptions // CSV dialect (see SetCSVOptions)
+
+	serverSideInMemory bool             // Apply _page/_sort/_search to data passed to SetData (see SetServerSideInMemory)
+	components         []core.Component // Additional components (charts, stats, progress bars, etc.)
 }
 
 // TableOptions contains all table configuration options.
@@ -52,6 +56,7 @@ type TableOptions struct {
 	Query         *bool
 	Csv           *bool
 	Excel         *bool
+	Pdf           *bool
 	SaveState     *bool
 	SaveStateId   *string
 	SaveInput     *string
@@ -70,12 +75,18 @@ type TableOptions struct {
 // This is where the magic happens: raw row structs are converted to formatted map[string]any
 // with all formatters applied and locale/unit conversions done automatically.
 func (t *Table[T]) GetData(output OutputType) []map[string]any {
+	return t.getData(t.data, output)
+}
+
+// getData formats the given rows for a specific output type.
+// Used by GetData() and by the in-memory server-side mode (with the current page only).
+func (t *Table[T]) getData(data []T, output OutputType) []map[string]any {
 	// Build field accessor map for Row interface
 	fieldMap := t.buildFieldMap()
 
-	rows := make([]map[string]any, len(t.data))
+	rows := make([]map[string]any, len(data))
 
-	for i, rowData := range t.data {
+	for i, rowData := range data {
 		// Create Row wrapper for cross-field access
 		row := NewTypedRow(rowData, fieldMap)
 
@@ -88,8 +99,8 @@ func (t *Table[T]) GetData(output OutputType) []map[string]any {
 				continue
 			}
 
-			// Skip non-CSV fields for CSV/Excel output
-			if (output == OutputCSV || output == OutputExcel) && !field.IsCsvEnabled() {
+			// Skip non-CSV fields for CSV/Excel/JSONL/ODS/HTML output
+			if output.isExport() && !field.IsCsvEnabled() {
 				continue
 			}
 
@@ -97,7 +108,12 @@ func (t *Table[T]) GetData(output OutputType) []map[string]any {
 			value := field.GetAccessor()(rowData)
 
 			// Format with access to entire row (for cross-field dependencies)
-			formatted := field.Format(value, row, output, t.ctx)
+			var formatted any
+			if output == OutputCSV {
+				formatted = field.formatCSV(value, row, t.csvOptions.Mode, t.ctx)
+			} else {
+				formatted = field.Format(value, row, output, t.ctx)
+			}
 
 			// Special handling for Link fields in OutputWeb/OutputPDF
 			// Link fields output TWO fields: fieldName (display text) and fieldNameLink (URL)
@@ -194,55 +210,75 @@ func (t *Table[T]) GetFilter() *group.FormGroup {
 
 // LoadFilterData parses filter data from request, detects CSV flag, and returns parsed filter values.
 // This is the main method controllers should use for handling table data requests.
+// The request is read through request.Source (request.FromHTTP for net/http,
+// echoadapter.Source for Echo).
 //
 // Returns:
 //   - Parsed filter values (empty map if no filter)
 //   - Error if parsing/validation fails
 //
 // Side effects:
-//   - Sets outputType to OutputCSV if _csv flag is true
+//   - Sets outputType to OutputCSV if _csv (or _tsv) flag is true
+//   - Sets outputType to OutputExcel / OutputPDF if _excel / _pdf flag is true
+//   - Sets outputType to OutputJSONL / OutputODS / OutputHTML if _jsonl / _ods / _html flag is true
+//   - Applies the CSV dialect parameters (_csvDelimiter, _csvBom, _csvCrlf, _csvMode; see CSVOptions)
 //   - Stores raw filter data in filterData field
 //
 // Example:
 //
 //	tbl := buildDeviceTable(ctx, translator)
-//	parsedFilters, err := tbl.LoadFilterData(c)
+//	parsedFilters, err := tbl.LoadFilterData(echoadapter.Source(c))
 //	if err != nil {
 //	    return wc.BadRequest(err.Error())
 //	}
 //	rows := fetchDevicesWithFilters(parsedFilters)
 //	tbl.SetData(rows)
 //	return wc.TableDataFromTable(tbl)
-func (t *Table[T]) LoadFilterData(c echo.Context) (map[string]any, error) {
+func (t *Table[T]) LoadFilterData(src request.Source) (map[string]any, error) {
 	// Parse request body (contains filter fields + _csv flag)
-	var requestData map[string]interface{}
-	if err := c.Bind(&requestData); err != nil {
+	requestData, err := request.Values(src)
+	if err != nil {
 		slog.Debug("LoadFilterData: failed to bind request body, using empty map", "error", err)
 		requestData = make(map[string]interface{})
 	}
 
-	// Check for CSV flag and set output type
-	if csvVal, ok := requestData["_csv"]; ok {
-		if csvBool, isBool := csvVal.(bool); isBool && csvBool {
-			t.outputType = OutputCSV
-		} else if csvStr, isStr := csvVal.(string); isStr && csvStr == "true" {
-			t.outputType = OutputCSV
-		}
+	// Check for CSV / TSV flag and set output type
+	if requestFlag(requestData["_csv"]) || requestFlag(requestData["_tsv"]) {
+		t.outputType = OutputCSV
 	}
 
 	// Check for Excel flag and set output type
-	if excelVal, ok := requestData["_excel"]; ok {
-		if excelBool, isBool := excelVal.(bool); isBool && excelBool {
-			t.outputType = OutputExcel
-		} else if excelStr, isStr := excelVal.(string); isStr && excelStr == "true" {
-			t.outputType = OutputExcel
-		}
+	if requestFlag(requestData["_excel"]) {
+		t.outputType = OutputExcel
+	}
+
+	// Check for PDF flag and set output type
+	if requestFlag(requestData["_pdf"]) {
+		t.outputType = OutputPDF
+	}
+
+	// Check for JSON Lines, ODS and HTML flags and set output type
+	if requestFlag(requestData["_jsonl"]) {
+		t.outputType = OutputJSONL
+	}
+	if requestFlag(requestData["_ods"]) {
+		t.outputType = OutputODS
+	}
+	if requestFlag(requestData["_html"]) {
+		t.outputType = OutputHTML
 	}
 
-	// Store filter data (exclude _csv, _excel and flags)
+	// CSV dialect selected by the request (delimiter, BOM, line endings, mode)
+	csvOptions, err := t.csvOptions.applyCSVRequest(requestData)
+	if err != nil {
+		return nil, err
+	}
+	t.csvOptions = csvOptions
+
+	// Store filter data (exclude output flags, CSV dialect and flags)
 	t.filterData = make(map[string]any)
 	for k, v := range requestData {
-		if k == "_csv" || k == "_excel" {
+		if slices.Contains(outputRequestParams, k) || slices.Contains(csvRequestParams, k) {
 			continue
 		}
 		// Check if field is a flag
@@ -321,7 +357,7 @@ type PaginationParams struct {
 //
 // Example:
 //
-//	filters, _ := tbl.LoadFilterData(c)
+//	filters, _ := tbl.LoadFilterData(echoadapter.Source(c))
 //	pagination := tbl.LoadPaginationParams()
 //	devices, total := dbm.Device.FindWithPagination(filters, pagination.Page, pagination.PageSize)
 func (t *Table[T]) LoadPaginationParams() PaginationParams {
@@ -397,6 +433,11 @@ func (t *Table[T]) GetOptions() TableOptions {
 // CalculateFooter computes footer aggregations for all fields with footer enabled.
 // Returns a map of field_id -> aggregated_value (formatted).
 func (t *Table[T]) CalculateFooter(output OutputType) map[string]any {
+	return t.calculateFooter(t.data, output)
+}
+
+// calculateFooter computes footer aggregations over the given rows.
+func (t *Table[T]) calculateFooter(data []T, output OutputType) map[string]any {
 	footer := make(map[string]any)
 	fieldMap := t.buildFieldMap()
 
@@ -409,9 +450,9 @@ func (t *Table[T]) CalculateFooter(output OutputType) map[string]any {
 
 		switch field.GetFooter() {
 		case FieldFooterSum:
-			aggregated = t.sumField(field)
+			aggregated = sumField(data, field)
 		case FieldFooterCount:
-			aggregated = t.countField(field)
+			aggregated = countField(data, field)
 		case FieldFooterStatic:
 			// Static footer values would be set separately
 			continue
@@ -419,8 +460,8 @@ func (t *Table[T]) CalculateFooter(output OutputType) map[string]any {
 
 		// Format footer value
 		// Use first row for Row context (for device-specific formatting)
-		if len(t.data) > 0 {
-			row := NewTypedRow(t.data[0], fieldMap)
+		if len(data) > 0 {
+			row := NewTypedRow(data[0], fieldMap)
 			formatted := field.Format(aggregated, row, output, t.ctx)
 			footer[field.GetID()] = formatted
 		} else {
@@ -434,11 +475,11 @@ func (t *Table[T]) CalculateFooter(output OutputType) map[string]any {
 }
 
 // sumField sums all values for a field
-func (t *Table[T]) sumField(field *Field[T]) float64 {
+func sumField[T any](data []T, field *Field[T]) float64 {
 	sum := 0.0
 	accessor := field.GetAccessor()
 
-	for _, rowData := range t.data {
+	for _, rowData := range data {
 		val := accessor(rowData)
 		sum += toFloat64(val)
 	}
@@ -447,11 +488,11 @@ func (t *Table[T]) sumField(field *Field[T]) float64 {
 }
 
 // countField counts non-empty values for a field
-func (t *Table[T]) countField(field *Field[T]) int {
+func countField[T any](data []T, field *Field[T]) int {
 	count := 0
 	accessor := field.GetAccessor()
 
-	for _, rowData := range t.data {
+	for _, rowData := range data {
 		val := accessor(rowData)
 		if val != nil && val != "" {
 			count++
@@ -834,6 +875,20 @@ func (t *Table[T]) exportOptions(translator core.TranslateFunc) map[string]any {
 		topButtons = append(topButtons, excelBtn)
 	}
 
+	// Auto-generate PDF download button if PDF option enabled and URL exists
+	if opts.Pdf != nil && *opts.Pdf && t.url != nil {
+		pdfBtn := button.NewTableButton(
+			core.ButtonActionDownload,
+			"explicit",
+			t.url,
+			"PDF",
+			core.ColorAccent,
+			false,
+			map[string]any{"data": map[string]bool{"_pdf": true}},
+		)
+		topButtons = append(topButtons, pdfBtn)
+	}
+
 	// Export ButtonsTop in same format as SelectButtons
 	if len(topButtons) > 0 {
 		buttons := make([]map[string]any, len(topButtons))
@@ -2,8 +2,9 @@ package table
 
 import (
 	"bytes"
-	"encoding/csv"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/xiriframework/xiri-go/component/core"
 	"github.com/xiriframework/xiri-go/response"
@@ -18,16 +19,23 @@ import (
 // JSON format: {"data": [...], "fields": [...], "footer": {...}, "components": [...]}
 // CSV format: {"csv": "field1;field2\nval1;val2\n"}
 // Excel format: {"excel": <binary bytes>}
+// PDF format: {"pdf": <binary bytes>}
+// JSON Lines format: {"jsonl": "{...}\n{...}\n"}
+// ODS format: {"ods": <binary bytes>}
+// HTML format: {"html": "<!DOCTYPE html>..."}
 type TableDataResponse struct {
-	data          []map[string]any // Required - table rows
-	fields        []map[string]any // Optional - field definitions if changed (for JSON output)
-	fieldsForCSV  []map[string]any // Internal - field definitions for CSV/Excel headers (not exported to JSON)
-	footer        map[string]any   // Optional - footer aggregations (sum, count)
-	components    []core.Component // Optional - additional UI components
-	outputType    OutputType       // Output type (Web, CSV, PDF, Excel)
-	includeFields bool             // Whether to include fields in JSON output
-	excelData     []byte           // Excel binary data (only populated for OutputExcel)
-	totalCount    *int             // Optional - total record count for server-side pagination
+	data          []map[string]any       // Required - table rows
+	fields        []map[string]any       // Optional - field definitions if changed (for JSON output)
+	fieldsForCSV  []map[string]any       // Internal - field definitions for CSV/Excel headers (not exported to JSON)
+	footer        map[string]any         // Optional - footer aggregations (sum, count)
+	components    []core.Component       // Optional - additional UI components
+	outputType    OutputType             // Output type (Web, CSV, PDF, Excel, JSONL, ODS, HTML)
+	csvOptions    CSVOptions             // CSV dialect (only used for OutputCSV)
+	title         *string                // Optional - report title (only used for OutputPDF, OutputODS, OutputHTML)
+	includeFields bool                   // Whether to include fields in JSON output
+	excelData     []byte                 // Excel binary data (only populated for OutputExcel)
+	excelFormats  map[string]excelFormat // Number formats and footer aggregations by field ID (only used for OutputExcel, OutputODS)
+	totalCount    *int                   // Optional - total record count for server-side pagination
 }
 
 // NewTableDataResponse creates a new TableDataResponse with the given row data and output type.
@@ -75,6 +83,32 @@ func (td *TableDataResponse) withFieldsForCSV(fields []map[string]any) *TableDat
 	return td
 }
 
+// WithCSVOptions sets the dialect of CSV output (delimiter, BOM, line endings).
+// CSVDelimiterAuto uses ";" here, as the response has no user context; Table[T]
+// resolves the delimiter from the user's locale.
+//
+// Usage:
+//
+//	td.WithCSVOptions(table.CSVOptions{Delimiter: ',', BOM: true})
+func (td *TableDataResponse) WithCSVOptions(opts CSVOptions) *TableDataResponse {
+	td.csvOptions = opts
+	return td
+}
+
+// withExcelFormats sets the number formats and footer aggregations of the Excel columns by field ID.
+// This is an internal method used by Table[T].ToTableDataResponse().
+func (td *TableDataResponse) withExcelFormats(formats map[string]excelFormat) *TableDataResponse {
+	td.excelFormats = formats
+	return td
+}
+
+// withTitle sets the report title used as page heading in PDF output.
+// This is an internal method used by Table[T].ToTableDataResponse().
+func (td *TableDataResponse) withTitle(title *string) *TableDataResponse {
+	td.title = title
+	return td
+}
+
 // WithFooter sets the footer aggregation data.
 // Footer data typically contains sums or counts for numeric columns.
 //
@@ -144,6 +178,16 @@ func (td *TableDataResponse) AddComponent(comp core.Component) *TableDataRespons
 //	{
 //	  "excel": <binary bytes>
 //	}
+//
+// Output format for OutputPDF:
+//
+//	{
+//	  "pdf": <binary bytes>
+//	}
+//
+// Output format for OutputJSONL, OutputODS and OutputHTML:
+//
+//	{"jsonl": "..."}, {"ods": <binary bytes>}, {"html": "..."}
 func (td *TableDataResponse) Print(translator core.TranslateFunc) map[string]any {
 	// Handle CSV output
 	if td.outputType == OutputCSV {
@@ -167,7 +211,56 @@ func (td *TableDataResponse) Print(translator core.TranslateFunc) map[string]any
 		}
 	}
 
-	// Handle regular JSON output (Web, PDF)
+	// Handle PDF output
+	if td.outputType == OutputPDF {
+		pdfBytes, err := td.generatePDF(translator)
+		if err != nil {
+			// On error, return empty PDF data
+			return map[string]any{
+				"pdf": []byte{},
+			}
+		}
+		return map[string]any{
+			"pdf": pdfBytes,
+		}
+	}
+
+	// Handle JSON Lines output
+	if td.outputType == OutputJSONL {
+		jsonl, err := td.generateJSONL()
+		if err != nil {
+			// On error, return empty JSON Lines data
+			return map[string]any{
+				"jsonl": "",
+			}
+		}
+		return map[string]any{
+			"jsonl": jsonl,
+		}
+	}
+
+	// Handle ODS output
+	if td.outputType == OutputODS {
+		odsBytes, err := td.generateODS(translator)
+		if err != nil {
+			// On error, return empty ODS data
+			return map[string]any{
+				"ods": []byte{},
+			}
+		}
+		return map[string]any{
+			"ods": odsBytes,
+		}
+	}
+
+	// Handle HTML output
+	if td.outputType == OutputHTML {
+		return map[string]any{
+			"html": td.generateHTML(translator),
+		}
+	}
+
+	// Handle regular JSON output (Web)
 	response := map[string]any{
 		"data": td.data,
 	}
@@ -205,17 +298,36 @@ func (td *TableDataResponse) Print(translator core.TranslateFunc) map[string]any
 	return response
 }
 
-// DataResponse returns a DataResult with the appropriate response type (JSON, CSV, or Excel).
+// DataResponse returns a DataResult with the appropriate response type (JSON, CSV, TSV, Excel, PDF,
+// JSON Lines, ODS or HTML).
 // Table responses are NOT wrapped in {"data": ...} — they have their own top-level structure.
 func (td *TableDataResponse) DataResponse(translator core.TranslateFunc) response.DataResult {
 	printed := td.Print(translator)
 
 	if csv, ok := printed["csv"].(string); ok {
-		return response.NewCSVDataResult(csv)
+		result := response.NewCSVDataResult(csv)
+		if td.csvOptions.IsTSV() {
+			result = response.NewTSVDataResult(csv)
+		}
+		// The CSV options decide about the BOM, response.Write must not add one
+		result.NoBOM = !td.csvOptions.BOM
+		return result
 	}
 	if excel, ok := printed["excel"].([]byte); ok {
 		return response.NewExcelDataResult(excel)
 	}
+	if pdf, ok := printed["pdf"].([]byte); ok {
+		return response.NewPDFDataResult(pdf)
+	}
+	if jsonl, ok := printed["jsonl"].(string); ok {
+		return response.NewJSONLDataResult(jsonl)
+	}
+	if ods, ok := printed["ods"].([]byte); ok {
+		return response.NewODSDataResult(ods)
+	}
+	if html, ok := printed["html"].(string); ok {
+		return response.NewHTMLDataResult(html)
+	}
 	return response.DataResult{Type: response.ResponseJSON, Body: printed}
 }
 
@@ -311,24 +423,11 @@ func (td *TableDataResponse) expandNFieldColumns() {
 	}
 }
 
-// generateCSV creates a CSV string from the table data.
-// Uses semicolon (;) delimiter for Excel compatibility.
-// Only includes fields that are marked as CSV-enabled.
-func (td *TableDataResponse) generateCSV(translator core.TranslateFunc) string {
-	td.expandNFieldColumns()
-
-	buf := new(bytes.Buffer)
-	writer := csv.NewWriter(buf)
-	writer.Comma = ';' // Semicolon separator for Excel compatibility (European locale)
-
-	// If no data, return empty CSV
-	if len(td.data) == 0 {
-		return ""
-	}
-
-	// Build field ID to name mapping from field definitions
-	fieldIDToName := make(map[string]string)
-	fieldIDsOrdered := make([]string, 0)
+// exportColumns returns the field IDs and translated header names of the CSV/Excel columns
+// in field definition order. Without field definitions the keys of the first row are used.
+func (td *TableDataResponse) exportColumns() ([]string, []string) {
+	ids := make([]string, 0)
+	names := make([]string, 0)
 
 	// Use fieldsForCSV first (internal), then fall back to fields (public)
 	fieldsToUse := td.fieldsForCSV
@@ -336,36 +435,45 @@ func (td *TableDataResponse) generateCSV(translator core.TranslateFunc) string {
 		fieldsToUse = td.fields
 	}
 
-	if fieldsToUse != nil && len(fieldsToUse) > 0 {
-		// Use field definitions to get proper order and names
-		for _, fieldDef := range fieldsToUse {
-			fieldID, hasID := fieldDef["id"].(string)
-			fieldName, hasName := fieldDef["name"].(string)
-			if hasID && hasName {
-				fieldIDToName[fieldID] = fieldName
-				fieldIDsOrdered = append(fieldIDsOrdered, fieldID)
+	// Use field definitions to get proper order and names
+	for _, fieldDef := range fieldsToUse {
+		fieldID, hasID := fieldDef["id"].(string)
+		fieldName, hasName := fieldDef["name"].(string)
+		if hasID && hasName {
+			if fieldName == "" {
+				fieldName = fieldID
 			}
+			ids = append(ids, fieldID)
+			names = append(names, fieldName)
 		}
 	}
 
-	// Fallback: if no field definitions, use keys from first row
-	if len(fieldIDsOrdered) == 0 {
-		firstRow := td.data[0]
-		for fieldID := range firstRow {
-			fieldIDsOrdered = append(fieldIDsOrdered, fieldID)
-			fieldIDToName[fieldID] = fieldID // Use ID as name
+	// Fallback: if no field definitions, use keys from first row (sorted for a stable order)
+	if len(ids) == 0 && len(td.data) > 0 {
+		for _, fieldID := range slices.Sorted(maps.Keys(td.data[0])) {
+			ids = append(ids, fieldID)
+			names = append(names, fieldID) // Use ID as name
 		}
 	}
+	return ids, names
+}
 
-	// Write header row with field names (translated)
-	headerRow := make([]string, len(fieldIDsOrdered))
-	for i, fieldID := range fieldIDsOrdered {
-		if name, ok := fieldIDToName[fieldID]; ok {
-			headerRow[i] = name
-		} else {
-			headerRow[i] = fieldID
-		}
+// generateCSV creates a CSV string from the table data in the dialect of csvOptions
+// (semicolon delimiter by default, for Excel in European locales).
+// Only includes fields that are marked as CSV-enabled.
+func (td *TableDataResponse) generateCSV(translator core.TranslateFunc) string {
+	td.expandNFieldColumns()
+
+	// If no data, return empty CSV
+	if len(td.data) == 0 {
+		return ""
 	}
+
+	buf := new(bytes.Buffer)
+	writer := newCSVWriter(buf, td.csvOptions.resolve(nil))
+
+	// Write header row with field names (translated)
+	fieldIDsOrdered, headerRow := td.exportColumns()
 	if err := writer.Write(headerRow); err != nil {
 		return fmt.Sprintf("Error writing CSV header: %v", err)
 	}
@@ -403,155 +511,46 @@ func (td *TableDataResponse) generateCSV(translator core.TranslateFunc) string {
 	return buf.String()
 }
 
-// generateExcel creates an Excel (.xlsx) file from the table data.
-// Uses excelize library to create a properly formatted Excel workbook.
-// Only includes fields that are marked as CSV-enabled (same logic as CSV).
-func (td *TableDataResponse) generateExcel(translator core.TranslateFunc) ([]byte, error) {
+// excelSheet returns the columns (with number formats and footer aggregations)
+// and cell values of the Excel output.
+func (td *TableDataResponse) excelSheet() excelSheet {
 	td.expandNFieldColumns()
 
-	// If no data, return empty Excel file
-	if len(td.data) == 0 {
-		f := excelize.NewFile()
-		defer f.Close()
-		buf, err := f.WriteToBuffer()
-		if err != nil {
-			return nil, fmt.Errorf("error creating empty Excel file: %w", err)
-		}
-		return buf.Bytes(), nil
-	}
-
-	// Create new Excel file
-	f := excelize.NewFile()
-	defer f.Close()
-
-	sheetName := "Sheet1"
-	index, err := f.NewSheet(sheetName)
-	if err != nil {
-		return nil, fmt.Errorf("error creating Excel sheet: %w", err)
-	}
-	f.SetActiveSheet(index)
-
-	// Build field ID to name mapping from field definitions
-	fieldIDToName := make(map[string]string)
-	fieldIDsOrdered := make([]string, 0)
-
-	// Use fieldsForCSV first (internal), then fall back to fields (public)
-	fieldsToUse := td.fieldsForCSV
-	if fieldsToUse == nil {
-		fieldsToUse = td.fields
-	}
-
-	if fieldsToUse != nil && len(fieldsToUse) > 0 {
-		// Use field definitions to get proper order and names
-		for _, fieldDef := range fieldsToUse {
-			fieldID, hasID := fieldDef["id"].(string)
-			fieldName, hasName := fieldDef["name"].(string)
-			if hasID && hasName {
-				fieldIDToName[fieldID] = fieldName
-				fieldIDsOrdered = append(fieldIDsOrdered, fieldID)
-			}
-		}
+	ids, names := td.exportColumns()
+	sheet := excelSheet{
+		columns: make([]excelColumn, len(ids)),
+		rows:    make([][]any, len(td.data)),
 	}
-
-	// Fallback: if no field definitions, use keys from first row
-	if len(fieldIDsOrdered) == 0 {
-		firstRow := td.data[0]
-		for fieldID := range firstRow {
-			fieldIDsOrdered = append(fieldIDsOrdered, fieldID)
-			fieldIDToName[fieldID] = fieldID // Use ID as name
-		}
-	}
-
-	// Write header row with field names
-	for colIdx, fieldID := range fieldIDsOrdered {
-		cellName, err := excelize.CoordinatesToCellName(colIdx+1, 1)
-		if err != nil {
-			return nil, fmt.Errorf("error getting cell name for header: %w", err)
-		}
-		name := fieldIDToName[fieldID]
-		if name == "" {
-			name = fieldID
-		}
-		if err := f.SetCellValue(sheetName, cellName, name); err != nil {
-			return nil, fmt.Errorf("error writing header cell: %w", err)
-		}
+	for i, fieldID := range ids {
+		sheet.columns[i] = excelColumn{name: names[i], excelFormat: td.excelFormats[fieldID]}
 	}
 
-	// Write data rows
 	for rowIdx, rowData := range td.data {
-		excelRow := rowIdx + 2 // Excel rows are 1-indexed, +1 for header
-		for colIdx, fieldID := range fieldIDsOrdered {
-			cellName, err := excelize.CoordinatesToCellName(colIdx+1, excelRow)
-			if err != nil {
-				return nil, fmt.Errorf("error getting cell name for data: %w", err)
-			}
-
-			// Get value
+		row := make([]any, len(ids))
+		for i, fieldID := range ids {
 			value := rowData[fieldID]
-			if value == nil {
-				continue // Leave cell empty
-			}
-
 			// Handle array values (e.g., [display, value] from formatters)
 			if arr, ok := value.([]interface{}); ok && len(arr) > 0 {
 				// For Excel, use the display value (first element)
 				value = arr[0]
 			}
-
-			// Write cell value
-			if err := f.SetCellValue(sheetName, cellName, value); err != nil {
-				return nil, fmt.Errorf("error writing data cell: %w", err)
-			}
+			row[i] = value
 		}
+		sheet.rows[rowIdx] = row
 	}
+	return sheet
+}
 
-	// Auto-size columns based on content
-	for colIdx, fieldID := range fieldIDsOrdered {
-		maxWidth := float64(10) // Minimum width
-
-		// Check header width
-		headerName := fieldIDToName[fieldID]
-		if headerName == "" {
-			headerName = fieldID
-		}
-		headerWidth := float64(len(headerName)) * 1.2
-		if headerWidth > maxWidth {
-			maxWidth = headerWidth
-		}
-
-		// Check data values in this column
-		for _, rowData := range td.data {
-			value := rowData[fieldID]
-			if value == nil {
-				continue
-			}
-
-			// Handle array values
-			if arr, ok := value.([]interface{}); ok && len(arr) > 0 {
-				value = arr[0]
-			}
-
-			// Convert to string and measure
-			valueStr := fmt.Sprintf("%v", value)
-			valueWidth := float64(len(valueStr)) * 1.2
-			if valueWidth > maxWidth {
-				maxWidth = valueWidth
-			}
-		}
-
-		// Cap at maximum width
-		if maxWidth > 50 {
-			maxWidth = 50
-		}
+// generateExcel creates an Excel (.xlsx) file from the table data.
+// Uses excelize library to write typed cells with number formats, a frozen header row,
+// auto-filter, auto-sized columns and footer formulas (see writeExcelSheet).
+// Only includes fields that are marked as CSV-enabled (same logic as CSV).
+func (td *TableDataResponse) generateExcel(translator core.TranslateFunc) ([]byte, error) {
+	f := excelize.NewFile()
+	defer f.Close()
 
-		// Set column width (columns are 1-indexed)
-		colName, err := excelize.ColumnNumberToName(colIdx + 1)
-		if err != nil {
-			continue // Skip on error, don't fail entire export
-		}
-		if err := f.SetColWidth(sheetName, colName, colName, maxWidth); err != nil {
-			continue // Skip on error, don't fail entire export
-		}
+	if err := writeExcelSheet(f, "Sheet1", td.excelSheet()); err != nil {
+		return nil, err
 	}
 
 	// Write to buffer
@@ -11,7 +11,8 @@ const (
 	// Integer creates an int32/int64 field with locale-aware number formatting.
 	// - Sets field type to FieldTypeNumber
 	// - Web/PDF output: locale-aware formatting (e.g., "1,234" or "1.234")
-	// - CSV/Excel output: plain number string
+	// - CSV output: plain number string
+	// - Excel output: numeric cell with "#,##0" format
 	// - Returns [display, value] array on web output for sorting
 	Integer FieldTypeHint = "integer"
 
@@ -19,7 +20,8 @@ const (
 	// - Sets field type to FieldTypeNumber
 	// - Default decimals: 2 (override with .WithDecimals(n))
 	// - Web/PDF output: locale-aware formatting (e.g., "123,45" or "123.45")
-	// - CSV/Excel output: formatted number string
+	// - CSV output: formatted number string
+	// - Excel output: numeric cell with "#,##0.00" format (per decimals)
 	// - Returns [display, value] array on web output for sorting
 	Float FieldTypeHint = "float"
 
@@ -32,20 +34,23 @@ const (
 	// - Sets field type to FieldTypeText
 	// - Requires .WithBoolText(trueText, falseText) to set display values
 	// - Default: shows "true"/"false" if WithBoolText not called
+	// - Excel output: boolean cell (TRUE/FALSE)
 	Bool FieldTypeHint = "bool"
 
 	// DateTime creates a timestamp field with date+time formatting.
 	// - Sets field type to FieldTypeText
 	// - Expects int64 Unix timestamp (seconds)
 	// - Web/PDF output: user timezone and locale (e.g., "2021-12-20 12:26")
-	// - CSV/Excel output: ISO format "2006-01-02 15:04:05" in user timezone
+	// - CSV output: ISO format "2006-01-02 15:04:05" in user timezone
+	// - Excel output: date cell in user timezone with "yyyy-mm-dd hh:mm:ss" format
 	DateTime FieldTypeHint = "datetime"
 
 	// Date creates a timestamp field with date-only formatting (no time component).
 	// - Sets field type to FieldTypeText
 	// - Expects int64 Unix timestamp (seconds)
 	// - Web/PDF output: user timezone and locale (e.g., "2021-12-20")
-	// - CSV/Excel output: ISO format "2006-01-02"
+	// - CSV output: ISO format "2006-01-02"
+	// - Excel output: date cell with "yyyy-mm-dd" format
 	Date FieldTypeHint = "date"
 
 	// Distance creates a float64 field with automatic unit conversion (km/mi/NM).
@@ -54,7 +59,8 @@ const (
 	// - Default decimals: 2 (override with .WithDecimals(n))
 	// - Automatically converts to miles or nautical miles based on user/device preference
 	// - Web/PDF output: formatted with unit (e.g., "123,45 km" or "76.72 mi")
-	// - CSV/Excel output: converted numeric value only
+	// - CSV output: converted numeric value only
+	// - Excel output: numeric cell with the converted value (format per decimals)
 	Distance FieldTypeHint = "distance"
 
 	// Pressure creates a float64 field with automatic unit conversion (bar/psi).
@@ -63,7 +69,8 @@ const (
 	// - Default decimals: 2 (override with .WithDecimals(n))
 	// - Automatically converts to psi based on user/device preference
 	// - Web/PDF output: formatted with unit (e.g., "2,50 bar" or "36.26 psi")
-	// - CSV/Excel output: converted numeric value only
+	// - CSV output: converted numeric value only
+	// - Excel output: numeric cell with the converted value (format per decimals)
 	Pressure FieldTypeHint = "pressure"
 
 	// Speed creates a float64 field with automatic unit conversion (km/h, mph, knots).
@@ -72,7 +79,8 @@ const (
 	// - Default decimals: 1 (override with .WithDecimals(n))
 	// - Automatically converts to mph or knots based on user/device preference
 	// - Web/PDF output: formatted with unit (e.g., "100,0 km/h" or "62.1 mph")
-	// - CSV/Excel output: converted numeric value only
+	// - CSV output: converted numeric value only
+	// - Excel output: numeric cell with the converted value (format per decimals)
 	Speed FieldTypeHint = "speed"
 
 	// Buttons creates a buttons-type field with action buttons.
@@ -194,7 +202,8 @@ const (
 	// - Sets field type to FieldTypeText
 	// - Expects int64 accessor (value in seconds)
 	// - Web/PDF output: "HH:MM" format (e.g., "05:30"), or "Xd HH:MM" for >= 24 hours (e.g., "2d 05:30")
-	// - CSV/Excel output: integer minutes (no decimals)
+	// - CSV output: integer minutes (no decimals)
+	// - Excel output: numeric cell with integer minutes
 	TimeLength FieldTypeHint = "timelength"
 
 	// Deprecated: Use [TimeLengthN] instead.
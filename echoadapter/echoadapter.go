@@ -0,0 +1,161 @@
+// Package echoadapter connects the library to the Echo v4 framework: it provides the
+// request.Source of an echo.Context, the DataResult writer and the Echo handlers for
+// options and dialog requests.
+package echoadapter
+
+import (
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+
+	"github.com/labstack/echo/v4"
+	"github.com/xiriframework/xiri-go/component/core"
+	"github.com/xiriframework/xiri-go/component/dialog"
+	xurl "github.com/xiriframework/xiri-go/component/url"
+	"github.com/xiriframework/xiri-go/form/builder"
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/request"
+	"github.com/xiriframework/xiri-go/response"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// echoSource is the request.Source of an echo.Context.
+type echoSource struct {
+	c echo.Context
+}
+
+// Source returns the request.Source of an echo.Context. The JSON body is decoded
+// with the JSONSerializer configured on the Echo instance.
+//
+// Example:
+//
+//	func (ctrl *Controller) AddSave(c echo.Context) error {
+//	    fg, _, _ := ctrl.deviceForm(ctx).BuildAdd()
+//	    if err := builder.BindAndValidate(echoadapter.Source(c), fg); err != nil {
+//	        return wc.BadRequest(err.Error())
+//	    }
+//	    ...
+//	}
+func Source(c echo.Context) request.Source {
+	return &echoSource{c: c}
+}
+
+func (s *echoSource) Method() string {
+	return s.c.Request().Method
+}
+
+func (s *echoSource) Header(name string) string {
+	return s.c.Request().Header.Get(name)
+}
+
+func (s *echoSource) Query() url.Values {
+	return s.c.QueryParams()
+}
+
+func (s *echoSource) DecodeBody(v any) error {
+	if s.c.Request().Body == nil || s.c.Request().ContentLength == 0 {
+		return io.EOF
+	}
+	return s.c.Echo().JSONSerializer.Deserialize(s.c, v)
+}
+
+func (s *echoSource) Form() (url.Values, error) {
+	return s.c.FormParams()
+}
+
+func (s *echoSource) MultipartReader() (*multipart.Reader, error) {
+	return s.c.Request().MultipartReader()
+}
+
+// ModelOptions answers a remote options request of a ModelField or ModelListField
+// (see builder.SearchModelOptions for the query parameters).
+// Invalid parameters are answered with 400 Bad Request.
+//
+// Example usage in controller:
+//
+//	func (ctrl *Controller) VehicleOptions(c echo.Context) error {
+//	    vehicle := field.NewModelField("vehicle", "VEHICLE", true, "device", 0).
+//	        SetRemoteOptions("/Portal/Trip/Options/vehicle", ctrl.deviceLoader)
+//	    return echoadapter.ModelOptions(c, ctx, vehicle)
+//	}
+func ModelOptions(c echo.Context, ctx *uicontext.UiContext, f field.RemoteOptionsField) error {
+	resp, err := builder.SearchModelOptions(ctx, f, c.QueryParams())
+	if err != nil {
+		if errors.Is(err, builder.ErrInvalidOptionsQuery) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// DependentOptions answers the options request of a dependent SelectField or ModelField
+// when its parent changes (see builder.LoadDependentOptions).
+//
+// Example usage in controller:
+//
+//	func (ctrl *Controller) RegionOptions(c echo.Context) error {
+//	    fg, _, _ := ctrl.addressForm(ctx).BuildAdd()
+//	    return echoadapter.DependentOptions(c, fg, "region")
+//	}
+func DependentOptions(c echo.Context, fg *group.FormGroup, fieldID string) error {
+	resp, err := builder.LoadDependentOptions(Source(c), fg, fieldID)
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// HandleDelRequest handles single-item delete operations using the standard dialog pattern.
+//
+// GET request: Shows delete confirmation dialog using dialog.NewDialogDelete
+// POST request: Executes delete operation and returns type-safe success response
+func HandleDelRequest(
+	c echo.Context,
+	confirmMessage string,
+	urlPath string,
+	deleteFunc func() error,
+	successResponse response.SuccessResponse,
+	translator core.TranslateFunc,
+) error {
+	if c.Request().Method == http.MethodGet {
+		dlg := dialog.NewDialogDelete(
+			confirmMessage,
+			xurl.NewUrl(urlPath),
+			nil,
+			nil,
+			nil,
+			nil,
+			translator,
+		)
+
+		return c.JSON(http.StatusOK, dlg.Print(translator))
+	}
+
+	if err := deleteFunc(); err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, successResponse)
+}
+
+// Write sends a DataResult as response of an echo.Context (see response.Write).
+//
+// Example:
+//
+//	func (ctrl *Controller) DeviceTableData(c echo.Context) error {
+//	    tbl := buildDeviceTable(ctx, translator)
+//	    if _, err := tbl.LoadFilterData(echoadapter.Source(c)); err != nil {
+//	        return wc.BadRequest(err.Error())
+//	    }
+//	    tbl.SetData(rows)
+//	    return echoadapter.Write(c, tbl.DataResponse(translator), response.WriteOptions{})
+//	}
+func Write(c echo.Context, result response.DataResult, opts response.WriteOptions) error {
+	return response.Write(c.Response(), c.Request(), result, opts)
+}
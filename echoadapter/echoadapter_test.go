@@ -0,0 +1,90 @@
+package echoadapter
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/xiriframework/xiri-go/form/builder"
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+func newContext(method, target, contentType, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	rec := httptest.NewRecorder()
+	return echo.New().NewContext(req, rec), rec
+}
+
+func TestSource_BindAndValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+	}{
+		{"json", "application/json", `{"name": "Truck", "count": 4, "extra": "ignored"}`},
+		{"form", "application/x-www-form-urlencoded", "name=Truck&count=4&extra=ignored"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name := field.NewTextField("name", "NAME", true, "")
+			count := field.NewIntField("count", "COUNT", true, 0)
+			fg := group.NewFormGroup([]field.FormField{name, count})
+
+			c, _ := newContext(http.MethodPost, "/", tt.contentType, tt.body)
+			if err := builder.BindAndValidate(Source(c), fg); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if name.Value == nil || *name.Value != "Truck" {
+				t.Errorf("expected name 'Truck', got %v", name.Value)
+			}
+			if count.Value == nil || *count.Value != 4 {
+				t.Errorf("expected count 4, got %v", count.Value)
+			}
+		})
+	}
+}
+
+func TestSource_EmptyBody(t *testing.T) {
+	c, _ := newContext(http.MethodPost, "/", "application/json", "")
+	var data map[string]interface{}
+	if err := Source(c).DecodeBody(&data); err == nil {
+		t.Error("expected io.EOF for empty body")
+	}
+}
+
+// staticLoader is a PagedModelLoader with a fixed option list.
+type staticLoader struct{}
+
+func (staticLoader) SearchOptions(ctx *uicontext.UiContext, query field.ModelOptionsQuery) (field.ModelOptionsPage, error) {
+	return field.ModelOptionsPage{Options: []field.ModelOption{{ID: 1, Name: "Truck"}}, Total: 1}, nil
+}
+
+func (staticLoader) ResolveOptions(ctx *uicontext.UiContext, modelType string, ids []int32) ([]field.ModelOption, error) {
+	return []field.ModelOption{{ID: 1, Name: "Truck"}}, nil
+}
+
+func TestModelOptions(t *testing.T) {
+	f := field.NewModelField("vehicle", "VEHICLE", true, "device", 0).SetRemoteOptions("/options", staticLoader{})
+
+	c, rec := newContext(http.MethodGet, "/options?search=Tr", "", "")
+	if err := ModelOptions(c, nil, f); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"name":"Truck"`) {
+		t.Errorf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+
+	c, _ = newContext(http.MethodGet, "/options?page=x", "", "")
+	var httpErr *echo.HTTPError
+	if err := ModelOptions(c, nil, f); !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 Bad Request, got %v", err)
+	}
+}
@@ -1,9 +1,11 @@
 package builder
 
 import (
-	"github.com/labstack/echo/v4"
+	"fmt"
+
 	"github.com/xiriframework/xiri-go/form/field"
 	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/request"
 )
 
 // BindAndValidate safely extracts form data for declared fields only,
@@ -17,7 +19,11 @@ import (
 //
 //	name.Value, groupID.Value, enabled.Value
 //
-// Supports both JSON and form-urlencoded/multipart request bodies.
+// The request is read through request.Source, so any HTTP framework can be used
+// (request.FromHTTP for net/http, echoadapter.Source for Echo).
+// Supports JSON, form-urlencoded and multipart/form-data request bodies.
+// Multipart file parts for FileFields are streamed through FileField.Receive
+// into the field's FileStore (see bindMultipart).
 //
 // Example usage in controller:
 //
@@ -36,7 +42,7 @@ import (
 //	    fg, _, _ := builder.BuildAdd()
 //
 //	    // Bind and validate - values stored in field instances
-//	    if err := formhelper.BindAndValidate(c, fg); err != nil {
+//	    if err := formhelper.BindAndValidate(echoadapter.Source(c), fg); err != nil {
 //	        return wc.BadRequest(err.Error())
 //	    }
 //
@@ -52,55 +58,36 @@ import (
 //	}
 //
 // Returns:
-//   - error: Validation error if any field fails validation
-func BindAndValidate(c echo.Context, fg *group.FormGroup) error {
-	// Get all declared field IDs from FormGroup
-	fieldIDs := fg.GetFieldIDs()
-
-	// Extract ONLY declared fields from request
-	formData := make(map[string]interface{})
-
-	// Check content type
-	contentType := c.Request().Header.Get("Content-Type")
+//   - error: group.ValidationErrors if any field fails validation (see group.AsValidationErrors),
+//     or the request binding error
+func BindAndValidate(src request.Source, fg *group.FormGroup) error {
+	if request.IsMultipart(src) {
+		return bindMultipart(src, fg)
+	}
 
-	if contentType == "application/json" || contentType == "application/json; charset=UTF-8" {
-		// Handle JSON body
-		var rawData map[string]interface{}
-		if err := c.Bind(&rawData); err != nil {
-			return err
-		}
+	formData, err := requestFormData(src, fg.GetFieldIDs())
+	if err != nil {
+		return err
+	}
+	return BindFromMap(formData, fg)
+}
 
-		// Filter to declared fields only (prevents over-posting)
-		for _, fieldID := range fieldIDs {
-			if value, exists := rawData[fieldID]; exists {
-				formData[fieldID] = value
-			}
-		}
-	} else {
-		// Handle form-urlencoded / multipart
-		// Parse form first
-		if err := c.Request().ParseForm(); err != nil {
-			return err
-		}
+// requestFormData extracts the values of the given IDs from a JSON or form-urlencoded
+// request body. Values for other keys are ignored (prevents over-posting).
+func requestFormData(src request.Source, fieldIDs []string) (map[string]interface{}, error) {
+	data, err := request.Values(src)
+	if err != nil {
+		return nil, err
+	}
 
-		for _, fieldID := range fieldIDs {
-			// Check if field exists in form
-			if values, exists := c.Request().Form[fieldID]; exists && len(values) > 0 {
-				if len(values) == 1 {
-					// Single value field
-					formData[fieldID] = values[0]
-				} else {
-					// Array field (e.g., ModelListField)
-					formData[fieldID] = values
-				}
-			} else if value := c.FormValue(fieldID); value != "" {
-				// Fallback to FormValue for single values
-				formData[fieldID] = value
-			}
+	// Filter to declared fields only (prevents over-posting)
+	formData := make(map[string]interface{})
+	for _, fieldID := range fieldIDs {
+		if value, exists := data[fieldID]; exists {
+			formData[fieldID] = value
 		}
 	}
-
-	return BindFromMap(formData, fg)
+	return formData, nil
 }
 
 // BindFromMap binds values from an already-parsed map to field instances.
@@ -111,14 +98,47 @@ func BindAndValidate(c echo.Context, fg *group.FormGroup) error {
 //   - Stores in field.Value property
 //
 // After this function returns, access values via field.Value (type-safe).
+//
+// All fields are bound and the group's cross-field rules are checked; if any fail,
+// a group.ValidationErrors with one entry per failing check is returned.
+//
+// Fields hidden or disabled by their conditions are skipped: they are neither required
+// nor bound, and values sent for them are ignored. Fields required by their RequiredWhen
+// condition must have a value (see FormGroup.EvaluateConditions). Dependent options are
+// loaded for the submitted parent values before binding (see FormGroup.LoadDependentOptions).
 func BindFromMap(formData map[string]interface{}, fg *group.FormGroup) error {
+	// Parse all values first, so field conditions see the submitted values
+	parsed := make(map[string]interface{})
+	parseErrs := make(map[string]error)
 	for _, f := range fg.GetFields() {
-		rawValue := resolveFieldValue(f, formData)
-		if err := bindFieldValue(f, rawValue); err != nil {
-			return err
+		if value, err := f.Parse(resolveFieldValue(f, formData)); err == nil {
+			parsed[f.GetID()] = value
+		} else {
+			parseErrs[f.GetID()] = err
 		}
 	}
-	return nil
+	if err := fg.LoadDependentOptions(parsed); err != nil {
+		return err
+	}
+	ignored := fg.EvaluateConditions(parsed)
+
+	var errs group.ValidationErrors
+	for _, f := range fg.GetFields() {
+		if ignored[f.GetID()] {
+			delete(parsed, f.GetID())
+			continue
+		}
+		if err := bindParsedValue(f, parsed[f.GetID()], parseErrs[f.GetID()], formData); err != nil {
+			errs.Add(f.GetID(), err)
+			delete(parsed, f.GetID())
+		} else if e := fg.RequiredWhenError(f.GetID(), parsed[f.GetID()]); e != nil {
+			errs = append(errs, e)
+			delete(parsed, f.GetID())
+		}
+	}
+
+	errs = append(errs, fg.ValidateRules(parsed, errs)...)
+	return errs.OrNil()
 }
 
 // resolveFieldValue extracts the raw value for a field from form data,
@@ -131,6 +151,24 @@ func resolveFieldValue(field field.FormField, formData map[string]interface{}) i
 	return rawValue
 }
 
+// bindParsedValue binds the value parsed by BindFromMap to a field. Fields with a
+// BindParsed method (e.g. group.RepeaterField, whose rows are expensive to parse)
+// get the parsed value or the parse error; other fields bind the raw value.
+func bindParsedValue(field field.FormField, parsed interface{}, parseErr error, formData map[string]interface{}) error {
+	type ParsedBinder interface {
+		BindParsed(parsed interface{}) error
+	}
+
+	binder, ok := field.(ParsedBinder)
+	if !ok {
+		return bindFieldValue(field, resolveFieldValue(field, formData))
+	}
+	if parseErr != nil {
+		return fmt.Errorf("parsing field %s: %w", field.GetID(), parseErr)
+	}
+	return binder.BindParsed(parsed)
+}
+
 // bindFieldValue binds a single raw value to a field instance.
 // It prefers the BindValue method if available, falling back to Parse+Validate.
 func bindFieldValue(field field.FormField, rawValue interface{}) error {
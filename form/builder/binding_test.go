@@ -3,6 +3,7 @@ package builder
 import (
 	"testing"
 
+	"github.com/xiriframework/xiri-go/component/url"
 	"github.com/xiriframework/xiri-go/form/field"
 	"github.com/xiriframework/xiri-go/form/group"
 )
@@ -68,6 +69,38 @@ func TestBindFromMap_ValidationError(t *testing.T) {
 	}
 }
 
+func TestBindFromMap_CollectsAllErrors(t *testing.T) {
+	name := field.NewTextFieldWithLength("name", "NAME", true, "", 5, 100)
+	count := field.NewIntFieldWithBounds("count", "COUNT", false, 0, 0, 10)
+	active := field.NewBoolField("active", "ACTIVE", false, false)
+
+	fg := group.NewFormGroup([]field.FormField{name, count, active})
+
+	data := map[string]interface{}{
+		"name":   "ab",
+		"count":  float64(20),
+		"active": true,
+	}
+
+	err := BindFromMap(data, fg)
+	verrs, ok := group.AsValidationErrors(err)
+	if !ok {
+		t.Fatalf("expected ValidationErrors, got %v", err)
+	}
+	if len(verrs) != 2 {
+		t.Fatalf("expected 2 errors, got %d: %v", len(verrs), verrs)
+	}
+	if verrs[0].Field != "name" || verrs[0].Code != field.ErrCodeMinLength {
+		t.Errorf("unexpected first error: %+v", verrs[0])
+	}
+	if verrs[1].Field != "count" || verrs[1].Code != field.ErrCodeRange {
+		t.Errorf("unexpected second error: %+v", verrs[1])
+	}
+	if active.Value == nil || !*active.Value {
+		t.Error("expected valid fields to be bound despite errors")
+	}
+}
+
 func TestNewFormBuilder_BuildAdd(t *testing.T) {
 	name := field.NewTextField("name", "NAME", true, "default-name")
 	active := field.NewBoolField("active", "ACTIVE", false, true)
@@ -155,3 +188,105 @@ func TestNewFormBuilder_OnEditValueCheck(t *testing.T) {
 		t.Errorf("expected 'modified-by-hook', got %v", values["name"])
 	}
 }
+
+func TestBindFromMap_Rules(t *testing.T) {
+	password := field.NewTextField("password", "PASSWORD", true, "")
+	confirm := field.NewTextField("confirm", "CONFIRM", true, "")
+
+	fg, _, err := NewFormBuilder(nil, nil).
+		AddField(password).
+		AddField(confirm).
+		AddRule(group.FieldsMatch("confirm", "password")).
+		BuildAdd()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = BindFromMap(map[string]interface{}{"password": "secret", "confirm": "other"}, fg)
+	verrs, ok := group.AsValidationErrors(err)
+	if !ok || len(verrs) != 1 || verrs[0].Field != "confirm" || verrs[0].Code != group.ErrCodeMismatch {
+		t.Errorf("expected mismatch error on confirm, got %v", err)
+	}
+
+	if err := BindFromMap(map[string]interface{}{"password": "secret", "confirm": "secret"}, fg); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestNewFormBuilder_BuildFormWithRules(t *testing.T) {
+	f, err := NewFormBuilder(nil, func(s string) string { return s }).
+		AddField(field.NewTextField("password", "PASSWORD", true, "")).
+		AddField(field.NewTextField("confirm", "CONFIRM", true, "")).
+		AddRule(group.FieldsMatch("confirm", "password")).
+		BuildForm(group.ScenarioAdd, url.NewUrl("/save"), nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data := f.Print(func(s string) string { return s })["data"].(map[string]any)
+	if fields := data["fields"].([]map[string]any); len(fields) != 2 {
+		t.Errorf("expected 2 fields, got %v", data["fields"])
+	}
+	if rules, ok := data["rules"].([]map[string]any); !ok || len(rules) != 1 || rules[0]["field"] != "confirm" {
+		t.Errorf("expected exported match rule, got %v", data["rules"])
+	}
+}
+
+// countingField counts Parse calls of a template field
+type countingField struct {
+	*field.TextField
+	parses int
+}
+
+func (f *countingField) Parse(raw interface{}) (interface{}, error) {
+	f.parses++
+	return f.TextField.Parse(raw)
+}
+
+func TestBindFromMap_RepeaterParsedOnce(t *testing.T) {
+	name := &countingField{TextField: field.NewTextField("name", "NAME", true, "")}
+	contacts := group.NewRepeaterField("contacts", "CONTACTS", true, group.NewFormGroup([]field.FormField{name}))
+	fg := group.NewFormGroup([]field.FormField{contacts})
+
+	raw := []interface{}{map[string]interface{}{"name": "Anna"}, map[string]interface{}{}}
+	err := BindFromMap(map[string]interface{}{"contacts": raw}, fg)
+	verrs, _ := group.AsValidationErrors(err)
+	if len(verrs) != 1 || verrs[0].Field != "contacts.1.name" || verrs[0].Code != field.ErrCodeRequired {
+		t.Errorf("expected one required error on contacts.1.name, got %v", err)
+	}
+	// Only the first row sends a name; BindValue must not parse it again
+	if name.parses != 1 {
+		t.Errorf("expected rows parsed once, got %d parses", name.parses)
+	}
+
+	if err := BindFromMap(map[string]interface{}{"contacts": raw[:1]}, fg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(contacts.Value) != 1 || contacts.Value[0]["name"] != "Anna" {
+		t.Errorf("unexpected rows %v", contacts.Value)
+	}
+}
+
+func TestNewFormBuilder_NoPermissionChecker(t *testing.T) {
+	role := field.NewTextField("role", "ROLE", false, "").SetAccess([]string{"admin"})
+
+	// Access is allowed by default: the restricted field is built and bound
+	fg, _, err := NewFormBuilder(nil, nil).AddField(role).BuildEdit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := BindFromMap(map[string]interface{}{"role": "admin"}, fg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if role.Value == nil || *role.Value != "admin" {
+		t.Errorf("expected restricted field bound without checker, got %v", role.Value)
+	}
+
+	role.Value = nil
+	fg, _, _ = NewFormBuilder(nil, nil).AddField(role).
+		SetPermissionChecker(group.PermissionFunc(func(p string) bool { return false })).
+		BuildEdit()
+	if err := BindFromMap(map[string]interface{}{"role": "admin"}, fg); err != nil || role.Value != nil {
+		t.Errorf("expected restricted field ignored with checker, got %v %v", role.Value, err)
+	}
+}
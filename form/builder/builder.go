@@ -2,6 +2,12 @@
 package builder
 
 import (
+	"fmt"
+	"reflect"
+
+	"github.com/xiriframework/xiri-go/component/button"
+	"github.com/xiriframework/xiri-go/component/form"
+	"github.com/xiriframework/xiri-go/component/url"
 	"github.com/xiriframework/xiri-go/form/field"
 	"github.com/xiriframework/xiri-go/form/group"
 	"github.com/xiriframework/xiri-go/uicontext"
@@ -18,6 +24,19 @@ type FormBuilder struct {
 
 	// Optional hook for edit edge cases (e.g., checking if current value is in options list)
 	OnEditValueCheck func(fg *group.FormGroup, values map[string]interface{}) error
+
+	// Checker for field Access permissions (nil = Access is not enforced, see SetPermissionChecker)
+	access group.PermissionChecker
+
+	// Cross-field validation rules, added to every built FormGroup
+	rules []group.Rule
+
+	// Last built FormGroup; BindInto only writes fields active in it
+	group *group.FormGroup
+
+	// Set by FromStruct: the source struct type and its field mapping for BindInto
+	structType reflect.Type
+	bindings   []structBinding
 }
 
 // NewFormBuilder creates a new FormBuilder.
@@ -40,40 +59,23 @@ func NewFormBuilder(ctx *uicontext.UiContext, translate func(string) string) *Fo
 //
 // Default values are taken from field definitions (defaultValue parameters).
 // Use this for displaying empty forms and for validation in AddSave.
+// Only fields applying to group.ScenarioAdd and accessible via the permission checker are included.
 func (fb *FormBuilder) BuildAdd() (*group.FormGroup, map[string]interface{}, error) {
-	// Create FormGroup with context
-	fg, err := group.NewFormGroupWithContext(fb.fields, fb.ctx)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	// Collect default values from fields
-	defaults := make(map[string]interface{})
-	for _, f := range fb.fields {
-		defaults[f.GetID()] = f.GetDefault()
-	}
-
-	return fg, defaults, nil
+	return fb.BuildScenario(group.ScenarioAdd)
 }
 
 // BuildEdit builds a FormGroup for Edit workflow.
 // Returns the FormGroup and a map of current values from field defaults.
+// Only fields applying to group.ScenarioEdit and accessible via the permission checker are included.
 //
 // If OnEditValueCheck is set, it will be called to handle edge cases
 // (e.g., current value not in accessible options list).
 func (fb *FormBuilder) BuildEdit() (*group.FormGroup, map[string]interface{}, error) {
-	// Create FormGroup with context
-	fg, err := group.NewFormGroupWithContext(fb.fields, fb.ctx)
+	fg, values, err := fb.BuildScenario(group.ScenarioEdit)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	// Collect current values from field defaults
-	values := make(map[string]interface{})
-	for _, f := range fb.fields {
-		values[f.GetID()] = f.GetDefault()
-	}
-
 	// Handle edge case via optional hook
 	if fb.OnEditValueCheck != nil {
 		if err := fb.OnEditValueCheck(fg, values); err != nil {
@@ -84,6 +86,55 @@ func (fb *FormBuilder) BuildEdit() (*group.FormGroup, map[string]interface{}, er
 	return fg, values, nil
 }
 
+// BuildScenario builds a FormGroup for the given scenario (e.g. group.ScenarioMultiEdit or a custom one).
+// Returns the FormGroup and a map of values from field defaults of the active fields.
+//
+// Example:
+//
+//	fg, values, err := fb.BuildScenario(group.ScenarioMultiEdit)
+func (fb *FormBuilder) BuildScenario(scenario string) (*group.FormGroup, map[string]interface{}, error) {
+	// Apply scope before the context, so options are only loaded for active fields
+	fg := group.NewFormGroup(fb.fields).
+		SetScenario(scenario).
+		SetPermissionChecker(fb.access).
+		AddRule(fb.rules...)
+	if err := fg.SetContext(fb.ctx); err != nil {
+		return nil, nil, fmt.Errorf("loading field options: %w", err)
+	}
+
+	// Collect values from field defaults
+	values := make(map[string]interface{})
+	for _, f := range fg.GetFields() {
+		values[f.GetID()] = f.GetDefault()
+	}
+
+	fb.group = fg
+	return fg, values, nil
+}
+
+// AddRule adds cross-field validation rules to the built FormGroups.
+//
+// Example:
+//
+//	fb.AddRule(group.CompareFields("end", field.CondGreater, "start"))
+func (fb *FormBuilder) AddRule(rules ...group.Rule) *FormBuilder {
+	fb.rules = append(fb.rules, rules...)
+	return fb
+}
+
+// SetPermissionChecker sets the checker used for field Access permissions.
+// Access is allowed by default: without a checker, fields with Access are built,
+// exported and bound like any other field, so clients can post values into them.
+// Always set a checker for forms with restricted fields.
+//
+// Example:
+//
+//	fb.SetPermissionChecker(group.PermissionFunc(user.HasPermission))
+func (fb *FormBuilder) SetPermissionChecker(checker group.PermissionChecker) *FormBuilder {
+	fb.access = checker
+	return fb
+}
+
 // AddField adds a field to the builder.
 func (fb *FormBuilder) AddField(field field.FormField) *FormBuilder {
 	fb.fields = append(fb.fields, field)
@@ -100,6 +151,22 @@ func (fb *FormBuilder) BuildAddForDisplay() ([]map[string]interface{}, error) {
 	return fg.ExportForFrontendWithValues(defaults), nil
 }
 
+// BuildForm builds the FormGroup for the scenario and returns the form component with
+// the field definitions and the frontend rules (see group.FormGroup.ExportRules).
+// Nil buttons create the default Back and Save buttons.
+//
+// Example:
+//
+//	f, err := fb.BuildForm(group.ScenarioEdit, u, nil, nil)
+func (fb *FormBuilder) BuildForm(scenario string, u *url.Url, header *string, buttons []*button.Button) (*form.Form, error) {
+	fg, values, err := fb.BuildScenario(scenario)
+	if err != nil {
+		return nil, err
+	}
+	return form.NewForm(fg.ExportForFrontendWithValues(values), u, header, buttons, nil, fb.translate).
+		WithRules(fg.ExportRules()), nil
+}
+
 // BuildEditForDisplay builds and exports field definitions for Edit workflow.
 // Returns frontend-ready JSON with current values from field defaults.
 func (fb *FormBuilder) BuildEditForDisplay() ([]map[string]interface{}, error) {
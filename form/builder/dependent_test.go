@@ -0,0 +1,114 @@
+package builder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/request"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// regionsByCountry is the test data for country -> region -> vehicle forms.
+var regionsByCountry = map[int32][]field.SelectOption{
+	1: {{Value: int32(10), Label: "Vienna"}, {Value: int32(11), Label: "Tyrol"}},
+	2: {{Value: int32(20), Label: "Bavaria"}},
+}
+
+func loadRegions(ctx *uicontext.UiContext, parent interface{}) ([]field.SelectOption, error) {
+	country, _ := parent.(int32)
+	return regionsByCountry[country], nil
+}
+
+func loadRegionVehicles(ctx *uicontext.UiContext, modelType string, parent interface{}) ([]field.ModelOption, error) {
+	region, _ := parent.(int32)
+	return []field.ModelOption{{ID: region*100 + 1, Name: "Truck"}}, nil
+}
+
+func dependentForm() (*group.FormGroup, *field.SelectField, *field.ModelField) {
+	country := field.NewSelectField("country", "COUNTRY", true, []field.SelectOption{
+		{Value: int32(1), Label: "Austria"},
+		{Value: int32(2), Label: "Germany"},
+	})
+	region := field.NewSelectField("region", "REGION", true, nil).
+		SetDependsOn("country", "/options/region", loadRegions)
+	vehicle := field.NewModelField("vehicle", "VEHICLE", true, "device", 0).
+		SetDependsOn("region", "/options/vehicle", loadRegionVehicles)
+	return group.NewFormGroup([]field.FormField{country, region, vehicle}), region, vehicle
+}
+
+func TestBindFromMap_DependentOptions(t *testing.T) {
+	fg, region, vehicle := dependentForm()
+
+	if err := BindFromMap(map[string]interface{}{"country": float64(2), "region": float64(20), "vehicle": float64(2001)}, fg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if region.Value != 20 || vehicle.Value != 2001 {
+		t.Errorf("unexpected values: region %v, vehicle %v", region.Value, vehicle.Value)
+	}
+
+	// Region of another country and vehicle of another region
+	err := BindFromMap(map[string]interface{}{"country": float64(1), "region": float64(20), "vehicle": float64(1001)}, fg)
+	errs, ok := group.AsValidationErrors(err)
+	if !ok || len(errs) != 2 || !errs.Has("region") || !errs.Has("vehicle") {
+		t.Errorf("expected invalid region and vehicle, got %v", err)
+	}
+
+	if err := fg.ValidateValues(map[string]interface{}{"country": int32(1), "region": int32(11), "vehicle": int32(1101)}); err != nil {
+		t.Errorf("unexpected validation error: %v", err)
+	}
+}
+
+func TestLoadDependentOptions(t *testing.T) {
+	fg, _, _ := dependentForm()
+
+	req := httptest.NewRequest(http.MethodGet, "/options/region?parent=1", nil)
+	resp, err := LoadDependentOptions(request.FromHTTP(req), fg, "region")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.List) != 2 || resp.List[1]["name"] != "Tyrol" {
+		t.Errorf("unexpected options: %v", resp.List)
+	}
+
+	if list, err := fg.RefreshDependentOptions("region", ""); err != nil || len(list) != 0 {
+		t.Errorf("expected no options without parent, got %v %v", list, err)
+	}
+	if _, err := fg.RefreshDependentOptions("country", "1"); err == nil {
+		t.Error("expected error for field without dependent options")
+	}
+}
+
+func TestValidateValues_DependentOptionsOfActiveParents(t *testing.T) {
+	var parents []interface{}
+	country := field.NewSelectField("country", "COUNTRY", false, []field.SelectOption{
+		{Value: int32(1), Label: "Austria"},
+	})
+	country.SetShowWhen("foreign", field.CondEquals, true)
+	region := field.NewSelectField("region", "REGION", false, nil).
+		SetDependsOn("country", "/options/region", func(ctx *uicontext.UiContext, parent interface{}) ([]field.SelectOption, error) {
+			parents = append(parents, parent)
+			return loadRegions(ctx, parent)
+		})
+	fg := group.NewFormGroup([]field.FormField{field.NewBoolField("foreign", "FOREIGN", false, false), country, region})
+
+	// Hidden parent: options are loaded as without parent value, once
+	hidden := map[string]interface{}{"foreign": false, "country": int32(1)}
+	for range 2 {
+		if err := fg.ValidateValues(hidden); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(parents) != 1 || parents[0] != nil {
+		t.Errorf("expected one load without parent value, got %v", parents)
+	}
+
+	if err := fg.ValidateValues(map[string]interface{}{"foreign": true, "country": int32(1), "region": int32(11)}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(parents) != 2 || parents[1] != int32(1) {
+		t.Errorf("expected reload for the visible parent, got %v", parents)
+	}
+}
@@ -0,0 +1,142 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/request"
+)
+
+// Limits of a multipart request
+const (
+	maxMultipartValuesSize = 10 << 20 // Total size of all non-file values
+	maxMultipartParts      = 1000     // Number of parts (values and files)
+	maxMultipartFiles      = 100      // Number of received files
+)
+
+// bindMultipart streams a multipart/form-data request into the form group.
+//
+// File parts of declared FileFields are passed to FileField.Receive while the body
+// is read, so MaxSize is enforced without buffering the upload. Other parts are
+// collected like form-urlencoded values. Parts for undeclared fields are skipped.
+//
+// Stored files are deleted again if binding fails or the field is hidden or
+// disabled by its conditions, so a rejected form leaves nothing in the FileStore.
+//
+// A request with more than maxMultipartParts parts, maxMultipartFiles files or
+// maxMultipartValuesSize bytes of non-file values (all values together) is rejected.
+// Further files for a single-file field are not received; the field fails with
+// ErrCodeMaxItems.
+func bindMultipart(src request.Source, fg *group.FormGroup) error {
+	reader, err := src.MultipartReader()
+	if err != nil {
+		return err
+	}
+
+	declared := make(map[string]field.FormField)
+	for _, f := range fg.GetFields() {
+		declared[f.GetID()] = f
+	}
+
+	formData := make(map[string]interface{})
+	uploads := make(map[string]field.FileValue)
+	failed := make(map[string]error)
+
+	discardAll := func() {
+		for id, files := range uploads {
+			declared[id].(*field.FileField).Discard(files)
+		}
+	}
+
+	parts, received := 0, 0
+	valueBudget := int64(maxMultipartValuesSize) // Bytes left for non-file values
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			discardAll()
+			return fmt.Errorf("reading multipart body: %w", err)
+		}
+		if parts++; parts > maxMultipartParts {
+			part.Close()
+			discardAll()
+			return fmt.Errorf("multipart body exceeds %d parts", maxMultipartParts)
+		}
+
+		id := part.FormName()
+		f, ok := declared[id]
+		if !ok {
+			part.Close()
+			continue
+		}
+
+		if ff, isFile := f.(*field.FileField); isFile {
+			// Empty file inputs are sent with an empty file name
+			if part.FileName() != "" && failed[id] == nil {
+				if !ff.Multiple && len(uploads[id]) > 0 {
+					// Do not store further files of a single-file field
+					failed[id] = field.NewFieldError(id, field.ErrCodeMaxItems, map[string]interface{}{"max": 1},
+						"file field %s accepts only one file", id)
+					part.Close()
+					continue
+				}
+				if received++; received > maxMultipartFiles {
+					part.Close()
+					discardAll()
+					return fmt.Errorf("multipart body exceeds %d files", maxMultipartFiles)
+				}
+				file, err := ff.Receive(part.FileName(), part)
+				if err != nil {
+					failed[id] = err
+				} else {
+					uploads[id] = append(uploads[id], file)
+				}
+			}
+			part.Close()
+			continue
+		}
+
+		data, err := io.ReadAll(io.LimitReader(part, valueBudget+1))
+		part.Close()
+		if err != nil {
+			discardAll()
+			return fmt.Errorf("reading multipart value %s: %w", id, err)
+		}
+		if valueBudget -= int64(len(data)); valueBudget < 0 {
+			discardAll()
+			return fmt.Errorf("multipart values exceed %d bytes", maxMultipartValuesSize)
+		}
+
+		// Repeated values become an array (e.g., ModelListField)
+		switch existing := formData[id].(type) {
+		case nil:
+			formData[id] = string(data)
+		case string:
+			formData[id] = []string{existing, string(data)}
+		case []string:
+			formData[id] = append(existing, string(data))
+		}
+	}
+
+	for id, files := range uploads {
+		formData[id] = files
+	}
+	for id, err := range failed {
+		declared[id].(*field.FileField).Discard(uploads[id])
+		delete(uploads, id)
+		formData[id] = err
+	}
+
+	err = BindFromMap(formData, fg)
+	for id, files := range uploads {
+		if err != nil || fg.IsFieldIgnored(id) {
+			declared[id].(*field.FileField).Discard(files)
+		}
+	}
+	return err
+}
@@ -0,0 +1,146 @@
+package builder
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/request"
+)
+
+// multipartContext creates a request source for a multipart request with the given values and files.
+func multipartContext(t *testing.T, values map[string]string, files map[string][]byte) request.Source {
+	t.Helper()
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for k, v := range values {
+		if err := w.WriteField(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, content := range files {
+		part, err := w.CreateFormFile("logo", name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		part.Write(content)
+	}
+	w.Close()
+
+	req := httptest.NewRequest(http.MethodPost, "/", &body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+	return request.FromHTTP(req)
+}
+
+func TestBindAndValidate_Multipart(t *testing.T) {
+	store := field.NewMemoryFileStore()
+	name := field.NewTextField("name", "NAME", true, "")
+	logo := field.NewFileField("logo", "LOGO", true, 1024).SetAllowedTypes("image/png").SetStore(store)
+	fg := group.NewFormGroup([]field.FormField{name, logo})
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	c := multipartContext(t, map[string]string{"name": "truck"}, map[string][]byte{"logo.png": png})
+	if err := BindAndValidate(c, fg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name.Value == nil || *name.Value != "truck" {
+		t.Errorf("unexpected name: %v", name.Value)
+	}
+	file := logo.Value.First()
+	if file == nil || file.ContentType != "image/png" || file.Name != "logo.png" {
+		t.Fatalf("unexpected upload: %v", logo.Value)
+	}
+	if _, ok := store.Get(file.Key); !ok {
+		t.Error("expected file in store")
+	}
+}
+
+func TestBindAndValidate_MultipartRejected(t *testing.T) {
+	store := field.NewMemoryFileStore()
+	name := field.NewTextFieldWithLength("name", "NAME", true, "", 3, 10)
+	logo := field.NewFileField("logo", "LOGO", true, 1024).SetAllowedTypes("image/png").SetStore(store)
+	fg := group.NewFormGroup([]field.FormField{name, logo})
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	// Valid file, but another field fails: the upload is removed again
+	c := multipartContext(t, map[string]string{"name": "x"}, map[string][]byte{"logo.png": png})
+	if err := BindAndValidate(c, fg); err == nil {
+		t.Fatal("expected validation error")
+	}
+	if store.Len() != 0 {
+		t.Errorf("expected upload to be discarded, got %d files", store.Len())
+	}
+
+	// Content is not a PNG, despite the name
+	c = multipartContext(t, map[string]string{"name": "truck"}, map[string][]byte{"logo.png": []byte("plain text")})
+	verrs, ok := group.AsValidationErrors(BindAndValidate(c, fg))
+	if !ok || len(verrs) != 1 || verrs[0].Field != "logo" || verrs[0].Code != field.ErrCodeFileType {
+		t.Errorf("expected fileType error on logo, got %v", verrs)
+	}
+}
+
+func TestBindAndValidate_MultipartSingleFileLimit(t *testing.T) {
+	store := field.NewMemoryFileStore()
+	logo := field.NewFileField("logo", "LOGO", true, 1024).SetStore(store)
+	fg := group.NewFormGroup([]field.FormField{logo})
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for _, name := range []string{"a.txt", "b.txt", "c.txt"} {
+		part, _ := w.CreateFormFile("logo", name)
+		part.Write([]byte("text"))
+	}
+	w.Close()
+	req := httptest.NewRequest(http.MethodPost, "/", &body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	verrs, ok := group.AsValidationErrors(BindAndValidate(request.FromHTTP(req), fg))
+	if !ok || len(verrs) != 1 || verrs[0].Code != field.ErrCodeMaxItems {
+		t.Errorf("expected maxItems error on logo, got %v", verrs)
+	}
+	if store.Len() != 0 {
+		t.Errorf("expected no stored files, got %d", store.Len())
+	}
+}
+
+func TestBindAndValidate_MultipartPartLimit(t *testing.T) {
+	fg := group.NewFormGroup([]field.FormField{field.NewTextField("name", "NAME", false, "")})
+
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for i := 0; i <= maxMultipartParts; i++ {
+		w.WriteField("name", "x")
+	}
+	w.Close()
+	req := httptest.NewRequest(http.MethodPost, "/", &body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	if err := BindAndValidate(request.FromHTTP(req), fg); err == nil {
+		t.Error("expected error for too many parts")
+	}
+}
+
+func TestBindAndValidate_MultipartValueBudget(t *testing.T) {
+	fg := group.NewFormGroup([]field.FormField{field.NewTextField("name", "NAME", false, "")})
+
+	// Each value is below the limit, all together exceed it
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	value := strings.Repeat("x", maxMultipartValuesSize/4+1)
+	for range 4 {
+		w.WriteField("name", value)
+	}
+	w.Close()
+	req := httptest.NewRequest(http.MethodPost, "/", &body)
+	req.Header.Set("Content-Type", w.FormDataContentType())
+
+	if err := BindAndValidate(request.FromHTTP(req), fg); err == nil || !strings.Contains(err.Error(), "values exceed") {
+		t.Errorf("expected error for too large values, got %v", err)
+	}
+}
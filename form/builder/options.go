@@ -0,0 +1,177 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/request"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// ModelOptionItem is a single option in a ModelOptionsResponse.
+type ModelOptionItem struct {
+	ID   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// ModelOptionsResponse is the JSON response for remote model options.
+//
+// JSON output: {"list": [{"id": 7, "name": "Truck 7"}], "page": 1, "pageSize": 50, "total": 1, "hasMore": false}
+//
+// total is -1 if the loader does not count the matches.
+type ModelOptionsResponse struct {
+	List     []ModelOptionItem `json:"list"`
+	Page     int               `json:"page"`
+	PageSize int               `json:"pageSize"`
+	Total    int               `json:"total"`
+	HasMore  bool              `json:"hasMore"`
+}
+
+// ErrInvalidOptionsQuery is returned for invalid query parameters of an options request.
+// HTTP adapters answer it with 400 Bad Request (see echoadapter.ModelOptions).
+var ErrInvalidOptionsQuery = errors.New("invalid options query")
+
+// SearchModelOptions loads the remote options of a ModelField or ModelListField requested
+// by the query parameters (see SetRemoteOptions on the fields).
+//
+// Query parameters:
+//   - search: search term (empty = all options)
+//   - page: page number, starting at 1 (default 1)
+//   - pageSize: options per page (default field.DefaultModelOptionsPageSize, at most field.MaxModelOptionsPageSize)
+//   - ids: selected IDs, comma-separated or repeated (at most field.MaxModelOptionsPageSize);
+//     returns these options instead of searching
+//
+// Invalid parameters return an error wrapping ErrInvalidOptionsQuery.
+//
+// Example usage in controller (net/http):
+//
+//	func (ctrl *Controller) VehicleOptions(w http.ResponseWriter, r *http.Request) {
+//	    vehicle := field.NewModelField("vehicle", "VEHICLE", true, "device", 0).
+//	        SetRemoteOptions("/Portal/Trip/Options/vehicle", ctrl.deviceLoader)
+//	    resp, err := builder.SearchModelOptions(ctx, vehicle, r.URL.Query())
+//	    ...
+//	}
+func SearchModelOptions(ctx *uicontext.UiContext, f field.RemoteOptionsField, query url.Values) (*ModelOptionsResponse, error) {
+	if !f.HasRemoteOptions() {
+		return nil, fmt.Errorf("field %s has no remote options", f.GetID())
+	}
+
+	page, err := queryInt(query, "page", 1)
+	if err != nil {
+		return nil, err
+	}
+	pageSize, err := queryInt(query, "pageSize", field.DefaultModelOptionsPageSize)
+	if err != nil {
+		return nil, err
+	}
+	if page < 1 || pageSize < 1 {
+		return nil, fmt.Errorf("%w: page and pageSize must be positive", ErrInvalidOptionsQuery)
+	}
+	pageSize = min(pageSize, field.MaxModelOptionsPageSize)
+
+	// Selected IDs: resolve instead of searching
+	if _, exists := query["ids"]; exists {
+		ids, err := queryIDs(query["ids"])
+		if err != nil {
+			return nil, err
+		}
+		options, err := f.ResolveOptions(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("resolving options for field %s: %w", f.GetID(), err)
+		}
+		return &ModelOptionsResponse{
+			List:     optionItems(options),
+			Page:     1,
+			PageSize: pageSize,
+			Total:    len(options),
+		}, nil
+	}
+
+	result, err := f.SearchOptions(ctx, strings.TrimSpace(query.Get("search")), page, pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("searching options for field %s: %w", f.GetID(), err)
+	}
+	return &ModelOptionsResponse{
+		List:     optionItems(result.Options),
+		Page:     page,
+		PageSize: pageSize,
+		Total:    result.Total,
+		HasMore:  result.HasMore,
+	}, nil
+}
+
+// queryInt returns an integer query parameter, or def if it is missing.
+func queryInt(query url.Values, name string, def int) (int, error) {
+	raw := query.Get(name)
+	if raw == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid %s: %q", ErrInvalidOptionsQuery, name, raw)
+	}
+	return n, nil
+}
+
+// queryIDs parses IDs given comma-separated and/or as repeated parameters.
+// More than field.MaxModelOptionsPageSize IDs are rejected.
+func queryIDs(values []string) ([]int32, error) {
+	var ids []int32
+	for _, value := range values {
+		for _, part := range strings.Split(value, ",") {
+			part = strings.TrimSpace(part)
+			if part == "" {
+				continue
+			}
+			if len(ids) == field.MaxModelOptionsPageSize {
+				return nil, fmt.Errorf("%w: more than %d ids", ErrInvalidOptionsQuery, field.MaxModelOptionsPageSize)
+			}
+			id, err := strconv.ParseInt(part, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid id: %q", ErrInvalidOptionsQuery, part)
+			}
+			ids = append(ids, int32(id))
+		}
+	}
+	return ids, nil
+}
+
+// optionItems converts model options into response items.
+func optionItems(options []field.ModelOption) []ModelOptionItem {
+	items := make([]ModelOptionItem, 0, len(options))
+	for _, opt := range options {
+		items = append(items, ModelOptionItem{ID: opt.ID, Name: opt.Name})
+	}
+	return items
+}
+
+// DependentOptionsResponse is the JSON response for the options of a dependent field.
+//
+// JSON output: {"list": [{"id": 3, "name": "Tyrol"}]}
+type DependentOptionsResponse struct {
+	List []map[string]interface{} `json:"list"`
+}
+
+// LoadDependentOptions loads the options of a dependent SelectField or ModelField
+// when its parent changes (see SetDependsOn on the fields). The query parameter "parent"
+// is the new parent value; it is parsed by the parent field.
+//
+// Example usage in controller (net/http):
+//
+//	func (ctrl *Controller) RegionOptions(w http.ResponseWriter, r *http.Request) {
+//	    fg, _, _ := ctrl.addressForm(ctx).BuildAdd()
+//	    resp, err := builder.LoadDependentOptions(request.FromHTTP(r), fg, "region")
+//	    ...
+//	}
+func LoadDependentOptions(src request.Source, fg *group.FormGroup, fieldID string) (*DependentOptionsResponse, error) {
+	list, err := fg.RefreshDependentOptions(fieldID, src.Query().Get("parent"))
+	if err != nil {
+		return nil, err
+	}
+	return &DependentOptionsResponse{List: list}, nil
+}
@@ -0,0 +1,128 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// testDeviceLoader serves devices 1..n named "Device <id>".
+type testDeviceLoader struct {
+	n        int
+	resolved [][]int32
+}
+
+func (l *testDeviceLoader) SearchOptions(ctx *uicontext.UiContext, query field.ModelOptionsQuery) (field.ModelOptionsPage, error) {
+	var matches []field.ModelOption
+	for id := 1; id <= l.n; id++ {
+		name := fmt.Sprintf("Device %d", id)
+		if strings.Contains(name, query.Search) {
+			matches = append(matches, field.ModelOption{ID: int32(id), Name: name})
+		}
+	}
+	start := min(query.Offset(), len(matches))
+	end := min(start+query.PageSize, len(matches))
+	return field.ModelOptionsPage{Options: matches[start:end], Total: len(matches), HasMore: end < len(matches)}, nil
+}
+
+func (l *testDeviceLoader) ResolveOptions(ctx *uicontext.UiContext, modelType string, ids []int32) ([]field.ModelOption, error) {
+	l.resolved = append(l.resolved, ids)
+	var options []field.ModelOption
+	for _, id := range ids {
+		if id >= 1 && int(id) <= l.n {
+			options = append(options, field.ModelOption{ID: id, Name: fmt.Sprintf("Device %d", id)})
+		}
+	}
+	return options, nil
+}
+
+func TestSearchModelOptions(t *testing.T) {
+	loader := &testDeviceLoader{n: 20000}
+	f := field.NewModelField("vehicle", "VEHICLE", true, "device", 0).SetRemoteOptions("/options/vehicle", loader)
+
+	resp, err := SearchModelOptions(nil, f, url.Values{"search": {"Device 1999"}, "page": {"2"}, "pageSize": {"5"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// "Device 1999" and "Device 19990".."Device 19999": 11 matches
+	if resp.Total != 11 || len(resp.List) != 5 || !resp.HasMore || resp.List[0].ID != 19994 {
+		t.Errorf("unexpected page: %+v", resp)
+	}
+
+	resp, err = SearchModelOptions(nil, f, url.Values{"ids": {"7,19999", "30000"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(resp.List) != 2 || resp.List[1].Name != "Device 19999" || resp.PageSize != field.DefaultModelOptionsPageSize {
+		t.Errorf("unexpected resolved options: %+v", resp)
+	}
+
+	resp, err = SearchModelOptions(nil, f, url.Values{"ids": {""}, "pageSize": {"10"}})
+	if err != nil || len(resp.List) != 0 || resp.PageSize != 10 {
+		t.Errorf("expected empty list with effective page size, got %+v %v", resp, err)
+	}
+
+	if _, err := SearchModelOptions(nil, f, url.Values{"page": {"x"}}); !errors.Is(err, ErrInvalidOptionsQuery) {
+		t.Errorf("expected query error, got %v", err)
+	}
+
+	tooMany := strings.Repeat("1,", field.MaxModelOptionsPageSize) + "1"
+	if _, err := SearchModelOptions(nil, f, url.Values{"ids": {tooMany}}); !errors.Is(err, ErrInvalidOptionsQuery) {
+		t.Errorf("expected query error for too many ids, got %v", err)
+	}
+	if _, err := SearchModelOptions(nil, field.NewModelField("g", "G", false, "group", 0), url.Values{}); err == nil {
+		t.Error("expected error for field without remote options")
+	}
+}
+
+func TestBindFromMap_RemoteOptions(t *testing.T) {
+	loader := &testDeviceLoader{n: 20000}
+	vehicle := field.NewModelField("vehicle", "VEHICLE", true, "device", 0).SetRemoteOptions("/options/vehicle", loader)
+	devices := field.NewModelListField("devices", "DEVICES", false, "device", nil).SetRemoteOptions("/options/devices", loader)
+	fg := group.NewFormGroup([]field.FormField{vehicle, devices})
+
+	if err := BindFromMap(map[string]interface{}{"vehicle": float64(15000), "devices": []interface{}{float64(3), float64(19000)}}, fg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vehicle.Value != 15000 || len(devices.Value) != 2 {
+		t.Errorf("unexpected values: %v %v", vehicle.Value, devices.Value)
+	}
+
+	err := BindFromMap(map[string]interface{}{"vehicle": float64(25000), "devices": []interface{}{float64(3), float64(20001)}}, fg)
+	errs, ok := group.AsValidationErrors(err)
+	if !ok || len(errs) != 2 || errs[0].Code != field.ErrCodeInvalidOption || errs[1].Code != field.ErrCodeInvalidOption {
+		t.Errorf("expected invalid option errors for both fields, got %v", err)
+	}
+
+	// Too many IDs are rejected before the loader is called
+	resolved := len(loader.resolved)
+	tooMany := make([]interface{}, field.MaxModelOptionsPageSize+1)
+	for i := range tooMany {
+		tooMany[i] = float64(i + 1)
+	}
+	err = BindFromMap(map[string]interface{}{"vehicle": float64(1), "devices": tooMany}, fg)
+	if errs, _ := group.AsValidationErrors(err); len(errs) != 1 || errs[0].Code != field.ErrCodeMaxItems {
+		t.Errorf("expected maxItems error, got %v", err)
+	}
+	for _, ids := range loader.resolved[resolved:] {
+		if len(ids) > field.MaxModelOptionsPageSize {
+			t.Errorf("expected loader not to resolve %d ids", len(ids))
+		}
+	}
+
+	// Export contains the selected option only
+	vehicle.Default = int32(42)
+	if err := vehicle.LoadOptions(nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := vehicle.ExportForFrontend(nil, nil)
+	if list := result["list"].([]map[string]interface{}); len(list) != 1 || list[0]["name"] != "Device 42" || result["remote"] != true {
+		t.Errorf("unexpected export: %v", result)
+	}
+}
@@ -0,0 +1,584 @@
+package builder
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// ============================================================================
+// Declarative Form Definition via Struct Tags
+// ============================================================================
+
+// structTagName is the struct tag key read by FromStruct.
+const structTagName = "xiri"
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	modelListType     = reflect.TypeOf([]int32{})
+	selectOptionsType = reflect.TypeOf([]field.SelectOption{})
+	uiContextType     = reflect.TypeOf((*uicontext.UiContext)(nil))
+)
+
+// structFieldTypes maps the type tag option to the form field type.
+var structFieldTypes = map[string]field.FieldType{
+	"text":      field.FieldTypeText,
+	"number":    field.FieldTypeInt,
+	"bool":      field.FieldTypeBool,
+	"select":    field.FieldTypeSelect,
+	"model":     field.FieldTypeModel,
+	"modellist": field.FieldTypeModelList,
+	"time":      field.FieldTypeTime,
+}
+
+// structBinding links a form field to the struct field it was derived from.
+type structBinding struct {
+	index int
+	field field.FormField
+}
+
+// FromStruct creates a FormBuilder from `xiri` struct tags on the struct obj points to.
+// Only exported fields with a `xiri` tag are added, in declaration order; use `xiri:"-"` to skip.
+// The current struct values are used as field defaults, so the same builder serves
+// the Add workflow (zero struct) and the Edit workflow (loaded struct).
+// After BindAndValidate(), BindInto() writes the validated values back into the struct.
+//
+// Tag options (comma separated key=value pairs, all optional):
+//   - id:       field ID (default: struct field name in lower camel case)
+//   - label:    translation key for the label (default: id)
+//   - type:     "text", "number", "bool", "select", "model", "modellist" or "time"
+//     (default: inferred from Go type; string, int, bool, time.Time, []int32)
+//   - required: "true"/"false"
+//   - min, max: bounds for number fields, length limits for text fields
+//   - subtype:  field subtype, e.g. "email", "textarea", "pint", "radio", "date"
+//   - options:  name of a method on the struct providing select options,
+//     with signature func(*uicontext.UiContext) []field.SelectOption
+//   - model:    model type for model and modellist fields
+//   - showWhen: visibility conditions "field:operator[:value]", multiple separated by ";"
+//     (between takes "min|max", e.g. "count:between:1|10")
+//   - hint:     tooltip text
+//   - class:    CSS class (e.g. "xcol-md-6")
+//   - step:     step indicator for multi-step forms
+//   - disabled: "true"/"false"
+//   - scenario: scenarios the field applies to, separated by "|" (e.g. "edit|multiedit")
+//   - access:   permissions required for the field, separated by "|"
+//
+// Pointer fields (*string, *int32, *bool, *time.Time, ...) are supported; nil means no value.
+//
+// Example:
+//
+//	type Device struct {
+//	    Name    string `xiri:"label=NAME,required=true,max=100"`
+//	    Kind    int32  `xiri:"label=TYP,type=select,options=KindOptions"`
+//	    Serial  string `xiri:"label=SERIAL,showWhen=kind:equals:2"`
+//	    GroupID int32  `xiri:"id=grp,label=GRUPPE,type=model,model=Group,required=true"`
+//	    Enabled bool   `xiri:"label=ENABLED"`
+//	}
+//
+//	func (d *Device) KindOptions(ctx *uicontext.UiContext) []field.SelectOption { ... }
+//
+//	fb, err := builder.FromStruct(ctx, t, &device)
+//	fg, _, _ := fb.BuildEdit()
+//	if err := builder.BindAndValidate(echoadapter.Source(c), fg); err != nil {
+//	    return wc.BadRequest(err.Error())
+//	}
+//	if err := fb.BindInto(&device); err != nil {
+//	    return err
+//	}
+func FromStruct(ctx *uicontext.UiContext, translate func(string) string, obj any) (*FormBuilder, error) {
+	ptr := reflect.ValueOf(obj)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() || ptr.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("builder.FromStruct: expected pointer to struct, got %T", obj)
+	}
+	value := ptr.Elem()
+	structType := value.Type()
+
+	fb := NewFormBuilder(ctx, translate)
+	fb.structType = structType
+
+	for i := range structType.NumField() {
+		sf := structType.Field(i)
+		tag, ok := sf.Tag.Lookup(structTagName)
+		if !ok || tag == "-" || !sf.IsExported() {
+			continue
+		}
+
+		opts, err := parseStructTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("builder.FromStruct: field %s: %w", sf.Name, err)
+		}
+
+		f, err := structFormField(ctx, ptr, sf, value.Field(i), opts)
+		if err != nil {
+			return nil, fmt.Errorf("builder.FromStruct: field %s: %w", sf.Name, err)
+		}
+
+		fb.AddField(f)
+		fb.bindings = append(fb.bindings, structBinding{index: i, field: f})
+	}
+
+	return fb, nil
+}
+
+// GetField returns the field with the given ID, for further configuration.
+//
+// Example:
+//
+//	if f, ok := fb.GetField("grp"); ok {
+//	    f.(*field.ModelField).SetLoaderFunc(loader)
+//	}
+func (fb *FormBuilder) GetField(id string) (field.FormField, bool) {
+	for _, f := range fb.fields {
+		if f.GetID() == id {
+			return f, true
+		}
+	}
+	return nil, false
+}
+
+// BindInto writes the bound field values back into the struct obj points to.
+// obj must be a pointer to the same struct type that was passed to FromStruct.
+// Call after BindAndValidate() or BindFromMap(). Fields dropped from the last built
+// FormGroup (by scenario or access) or hidden or disabled by their conditions in the
+// last binding are not written, so their struct values are kept.
+func (fb *FormBuilder) BindInto(obj any) error {
+	if fb.structType == nil {
+		return fmt.Errorf("BindInto: form builder was not created with FromStruct")
+	}
+	ptr := reflect.ValueOf(obj)
+	if ptr.Kind() != reflect.Pointer || ptr.IsNil() || ptr.Elem().Type() != fb.structType {
+		return fmt.Errorf("BindInto: expected *%s, got %T", fb.structType, obj)
+	}
+	value := ptr.Elem()
+
+	for _, b := range fb.bindings {
+		if fb.group != nil {
+			if _, active := fb.group.GetField(b.field.GetID()); !active || fb.group.IsFieldIgnored(b.field.GetID()) {
+				continue
+			}
+		}
+		if err := setStructField(value.Field(b.index), b.field); err != nil {
+			return fmt.Errorf("BindInto: field %s: %w", b.field.GetID(), err)
+		}
+	}
+	return nil
+}
+
+// structFormField creates the form field for a single struct field.
+func structFormField(ctx *uicontext.UiContext, ptr reflect.Value, sf reflect.StructField, current reflect.Value, opts map[string]string) (field.FormField, error) {
+	id := opts["id"]
+	if id == "" {
+		id = strings.ToLower(sf.Name[:1]) + sf.Name[1:]
+	}
+	label := opts["label"]
+	if label == "" {
+		label = id
+	}
+	required, err := tagBool(opts, "required")
+	if err != nil {
+		return nil, err
+	}
+
+	goType := sf.Type
+	if goType.Kind() == reflect.Pointer {
+		goType = goType.Elem()
+	}
+
+	var fieldType field.FieldType
+	if name := opts["type"]; name != "" {
+		var ok bool
+		fieldType, ok = structFieldTypes[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown type %q", name)
+		}
+		if err := checkStructFieldType(fieldType, goType); err != nil {
+			return nil, err
+		}
+	} else {
+		fieldType, err = inferFormFieldType(goType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	minVal, hasMin, err := tagInt(opts, "min")
+	if err != nil {
+		return nil, err
+	}
+	maxVal, hasMax, err := tagInt(opts, "max")
+	if err != nil {
+		return nil, err
+	}
+
+	// Current struct value (nil for nil pointers)
+	var currentValue any
+	if current.Kind() != reflect.Pointer || !current.IsNil() {
+		currentValue = reflect.Indirect(current).Interface()
+	}
+
+	var f field.FormField
+	var base *field.BaseField
+
+	switch fieldType {
+	case field.FieldTypeText:
+		tf := field.NewTextField(id, label, required, "")
+		tf.Default = nil
+		if currentValue != nil {
+			tf.Default = reflect.Indirect(current).String()
+		}
+		tf.MinLength = minVal
+		tf.MaxLength = maxVal
+		tf.Subtype = opts["subtype"]
+		f, base = tf, tf.BaseField
+
+	case field.FieldTypeInt:
+		intf := field.NewIntField(id, label, required, 0)
+		intf.Default = nil
+		if currentValue != nil {
+			intf.Default = int(reflect.Indirect(current).Int())
+		}
+		if hasMin {
+			intf.Min = &minVal
+		}
+		if hasMax {
+			intf.Max = &maxVal
+		}
+		intf.Subtype = opts["subtype"]
+		f, base = intf, intf.BaseField
+
+	case field.FieldTypeBool:
+		bf := field.NewBoolField(id, label, required, false)
+		bf.Default = nil
+		if currentValue != nil {
+			bf.Default = reflect.Indirect(current).Bool()
+		}
+		f, base = bf, bf.BaseField
+
+	case field.FieldTypeSelect:
+		options, err := structSelectOptions(ctx, ptr, opts["options"])
+		if err != nil {
+			return nil, err
+		}
+		sel := field.NewSelectField(id, label, required, options)
+		if currentValue != nil {
+			sel.Default = selectDefault(options, reflect.Indirect(current).Int())
+		}
+		sel.Subtype = opts["subtype"]
+		f, base = sel, sel.BaseField
+
+	case field.FieldTypeModel:
+		var modelID int32
+		if currentValue != nil {
+			modelID = int32(reflect.Indirect(current).Int())
+		}
+		mf := field.NewModelField(id, label, required, opts["model"], modelID)
+		f, base = mf, mf.BaseField
+
+	case field.FieldTypeModelList:
+		var ids []int32
+		if currentValue != nil {
+			ids = reflect.Indirect(current).Convert(modelListType).Interface().([]int32)
+		}
+		mlf := field.NewModelListField(id, label, required, opts["model"], ids)
+		f, base = mlf, mlf.BaseField
+
+	case field.FieldTypeTime:
+		timef := field.NewTimeField(id, label, required, 0)
+		timef.Default = nil
+		switch v := currentValue.(type) {
+		case time.Time:
+			if !v.IsZero() {
+				timef.Default = v.Unix()
+			}
+		case nil:
+		default:
+			timef.Default = reflect.Indirect(current).Int()
+		}
+		if hasMin {
+			minUnix := int64(minVal)
+			timef.Min = &minUnix
+		}
+		if hasMax {
+			maxUnix := int64(maxVal)
+			timef.Max = &maxUnix
+		}
+		timef.Subtype = opts["subtype"]
+		f, base = timef, timef.BaseField
+	}
+
+	if err := applyStructTagOptions(base, opts); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// inferFormFieldType derives the form field type from the Go type of a struct field.
+func inferFormFieldType(t reflect.Type) (field.FieldType, error) {
+	switch {
+	case t == timeType:
+		return field.FieldTypeTime, nil
+	case t.Kind() == reflect.String:
+		return field.FieldTypeText, nil
+	case t.Kind() == reflect.Bool:
+		return field.FieldTypeBool, nil
+	case isIntKind(t.Kind()):
+		return field.FieldTypeInt, nil
+	case t.ConvertibleTo(modelListType) && t.Kind() == reflect.Slice:
+		return field.FieldTypeModelList, nil
+	}
+	return "", fmt.Errorf("cannot infer form field type from %s, set type=...", t)
+}
+
+// checkStructFieldType verifies that a struct field's Go type can hold the value of the form field type.
+func checkStructFieldType(fieldType field.FieldType, t reflect.Type) error {
+	ok := false
+	switch fieldType {
+	case field.FieldTypeText:
+		ok = t.Kind() == reflect.String
+	case field.FieldTypeBool:
+		ok = t.Kind() == reflect.Bool
+	case field.FieldTypeInt, field.FieldTypeSelect, field.FieldTypeModel:
+		ok = isIntKind(t.Kind())
+	case field.FieldTypeModelList:
+		ok = t.Kind() == reflect.Slice && t.ConvertibleTo(modelListType)
+	case field.FieldTypeTime:
+		ok = t == timeType || t.Kind() == reflect.Int64
+	}
+	if !ok {
+		return fmt.Errorf("type %s is not compatible with %s", fieldType, t)
+	}
+	return nil
+}
+
+// structSelectOptions calls the options provider method on the struct.
+func structSelectOptions(ctx *uicontext.UiContext, ptr reflect.Value, method string) ([]field.SelectOption, error) {
+	if method == "" {
+		return nil, fmt.Errorf("select fields need options=<method>")
+	}
+	m := ptr.MethodByName(method)
+	if !m.IsValid() {
+		return nil, fmt.Errorf("options method %s not found on %s", method, ptr.Type())
+	}
+	mt := m.Type()
+	if mt.NumIn() != 1 || mt.In(0) != uiContextType || mt.NumOut() != 1 || mt.Out(0) != selectOptionsType {
+		return nil, fmt.Errorf("options method %s must have signature func(*uicontext.UiContext) []field.SelectOption", method)
+	}
+	return m.Call([]reflect.Value{reflect.ValueOf(ctx)})[0].Interface().([]field.SelectOption), nil
+}
+
+// selectDefault returns the option value matching the current struct value, so the
+// default has the same type as the options (int, int32 or int64).
+// Falls back to the first option for zero values, like NewSelectField.
+func selectDefault(options []field.SelectOption, current int64) any {
+	for _, opt := range options {
+		switch v := opt.Value.(type) {
+		case int:
+			if int64(v) == current {
+				return v
+			}
+		case int32:
+			if int64(v) == current {
+				return v
+			}
+		case int64:
+			if v == current {
+				return v
+			}
+		}
+	}
+	if current == 0 && len(options) > 0 {
+		return options[0].Value
+	}
+	return int32(current)
+}
+
+// applyStructTagOptions applies the common tag options to the field.
+func applyStructTagOptions(base *field.BaseField, opts map[string]string) error {
+	for key, value := range opts {
+		switch key {
+		case "id", "label", "type", "required", "min", "max", "subtype", "options", "model":
+			// Handled by structFormField
+		case "showWhen":
+			for cond := range strings.SplitSeq(value, ";") {
+				parts := strings.SplitN(strings.TrimSpace(cond), ":", 3)
+				if len(parts) < 2 || parts[0] == "" {
+					return fmt.Errorf("invalid showWhen %q (expected field:operator[:value])", cond)
+				}
+				op := field.ConditionOperator(parts[1])
+				switch op {
+				case field.CondNotEmpty:
+					base.SetShowWhenNotEmpty(parts[0])
+				case field.CondEquals, field.CondNotEquals, field.CondContains, field.CondGreater, field.CondLess, field.CondIn,
+					field.CondBetween, field.CondStartsWith, field.CondRegex, field.CondLengthGreater:
+					if len(parts) != 3 {
+						return fmt.Errorf("showWhen operator %s needs a value", op)
+					}
+					if op == field.CondBetween {
+						bounds := strings.Split(parts[2], "|")
+						if len(bounds) != 2 {
+							return fmt.Errorf("showWhen operator between needs min|max, got %q", parts[2])
+						}
+						base.ShowWhen = append(base.ShowWhen, field.NewConditionBetween(parts[0], conditionValue(bounds[0]), conditionValue(bounds[1])))
+						continue
+					}
+					base.SetShowWhen(parts[0], op, conditionValue(parts[2]))
+				default:
+					return fmt.Errorf("invalid showWhen operator %q", parts[1])
+				}
+			}
+		case "hint":
+			base.SetHint(value)
+		case "class":
+			base.SetClass(value)
+		case "step":
+			step, err := strconv.Atoi(value)
+			if err != nil {
+				return fmt.Errorf("invalid step %q", value)
+			}
+			base.SetStep(step)
+		case "disabled":
+			disabled, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("invalid disabled %q", value)
+			}
+			base.SetDisabled(disabled)
+		case "scenario":
+			base.SetScenario(strings.Split(value, "|"))
+		case "access":
+			base.SetAccess(strings.Split(value, "|"))
+		default:
+			return fmt.Errorf("unknown tag option %q", key)
+		}
+	}
+	return nil
+}
+
+// conditionValue converts a showWhen value from the tag into a number or bool where possible,
+// so it compares equal to the JSON value sent by the frontend.
+func conditionValue(s string) any {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n
+	}
+	if b, err := strconv.ParseBool(s); err == nil {
+		return b
+	}
+	return s
+}
+
+// setStructField stores the bound value of a form field in the struct field.
+func setStructField(target reflect.Value, f field.FormField) error {
+	var value any
+	switch tf := f.(type) {
+	case *field.TextField:
+		value = derefOrNil(tf.Value)
+	case *field.IntField:
+		value = derefOrNil(tf.Value)
+	case *field.BoolField:
+		value = derefOrNil(tf.Value)
+	case *field.SelectField:
+		value = tf.Value
+	case *field.ModelField:
+		value = tf.Value
+	case *field.ModelListField:
+		value = []int32(tf.Value)
+	case *field.TimeField:
+		value = derefOrNil(tf.Value)
+	default:
+		return fmt.Errorf("unsupported field type %T", f)
+	}
+
+	// Pointer targets: nil stays nil, otherwise allocate
+	if target.Kind() == reflect.Pointer {
+		if value == nil {
+			target.Set(reflect.Zero(target.Type()))
+			return nil
+		}
+		if target.IsNil() {
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		target = target.Elem()
+	}
+	if value == nil {
+		target.Set(reflect.Zero(target.Type()))
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		target.SetString(v)
+	case bool:
+		target.SetBool(v)
+	case int32:
+		target.SetInt(int64(v))
+	case int64:
+		if target.Type() == timeType {
+			target.Set(reflect.ValueOf(time.Unix(v, 0)))
+		} else {
+			target.SetInt(v)
+		}
+	case []int32:
+		target.Set(reflect.ValueOf(v).Convert(target.Type()))
+	}
+	return nil
+}
+
+// derefOrNil returns the pointed-to value or nil for nil pointers.
+func derefOrNil[V any](p *V) any {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
+
+// parseStructTag splits a `xiri` tag into key/value options.
+func parseStructTag(tag string) (map[string]string, error) {
+	opts := make(map[string]string)
+	for part := range strings.SplitSeq(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid tag option %q (expected key=value)", part)
+		}
+		opts[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return opts, nil
+}
+
+// tagBool reads an optional boolean tag option.
+func tagBool(opts map[string]string, key string) (bool, error) {
+	value, ok := opts[key]
+	if !ok {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s %q", key, value)
+	}
+	return b, nil
+}
+
+// tagInt reads an optional integer tag option.
+func tagInt(opts map[string]string, key string) (int, bool, error) {
+	value, ok := opts[key]
+	if !ok {
+		return 0, false, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid %s %q", key, value)
+	}
+	return n, true, nil
+}
+
+// isIntKind reports whether k is a signed integer kind.
+func isIntKind(k reflect.Kind) bool {
+	return k >= reflect.Int && k <= reflect.Int64
+}
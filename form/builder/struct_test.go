@@ -0,0 +1,300 @@
+package builder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+type testDevice struct {
+	Name     string    `xiri:"label=NAME,required=true,max=10"`
+	Kind     int32     `xiri:"label=TYP,type=select,options=KindOptions"`
+	Serial   string    `xiri:"label=SERIAL,showWhen=kind:equals:2"`
+	GroupID  int32     `xiri:"id=grp,label=GRUPPE,type=model,model=Group"`
+	Count    int       `xiri:"label=COUNT,min=0,max=100"`
+	Enabled  bool      `xiri:"label=ENABLED"`
+	Devices  []int32   `xiri:"label=DEVICES,model=device"`
+	Started  time.Time `xiri:"label=STARTED,subtype=date"`
+	Note     *string   `xiri:"label=NOTE"`
+	Internal string
+	Skipped  string `xiri:"-"`
+}
+
+func (d *testDevice) KindOptions(ctx *uicontext.UiContext) []field.SelectOption {
+	return []field.SelectOption{
+		{Value: int32(1), Label: "KIND_A"},
+		{Value: int32(2), Label: "KIND_B"},
+	}
+}
+
+func TestFromStruct_Fields(t *testing.T) {
+	fb, err := FromStruct(nil, nil, &testDevice{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	fg, _, err := fb.BuildAdd()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ids := fg.GetFieldIDs()
+	expected := []string{"name", "kind", "serial", "grp", "count", "enabled", "devices", "started", "note"}
+	if len(ids) != len(expected) {
+		t.Fatalf("expected fields %v, got %v", expected, ids)
+	}
+	for i, id := range expected {
+		if ids[i] != id {
+			t.Errorf("field %d: expected %s, got %s", i, id, ids[i])
+		}
+	}
+
+	name, _ := fb.GetField("name")
+	tf := name.(*field.TextField)
+	if !tf.Required || tf.MaxLength != 10 || tf.Name != "NAME" {
+		t.Errorf("unexpected name field: required=%v max=%d label=%s", tf.Required, tf.MaxLength, tf.Name)
+	}
+
+	kind, _ := fb.GetField("kind")
+	if sel := kind.(*field.SelectField); len(sel.Options) != 2 {
+		t.Errorf("expected 2 select options, got %d", len(sel.Options))
+	}
+
+	serial, _ := fb.GetField("serial")
+	showWhen := serial.(*field.TextField).ShowWhen
+	if len(showWhen) != 1 || showWhen[0].Field != "kind" || showWhen[0].Operator != field.CondEquals || showWhen[0].Value != 2 {
+		t.Errorf("unexpected showWhen: %+v", showWhen)
+	}
+
+	grp, _ := fb.GetField("grp")
+	if mf := grp.(*field.ModelField); mf.ModelType != "Group" {
+		t.Errorf("expected model type Group, got %s", mf.ModelType)
+	}
+
+	count, _ := fb.GetField("count")
+	intf := count.(*field.IntField)
+	if intf.Min == nil || *intf.Min != 0 || intf.Max == nil || *intf.Max != 100 {
+		t.Errorf("expected bounds 0..100, got %v..%v", intf.Min, intf.Max)
+	}
+
+	if _, ok := fb.GetField("devices"); !ok {
+		t.Error("expected modellist field for []int32")
+	}
+	if started, _ := fb.GetField("started"); started.(*field.TimeField).Subtype != "date" {
+		t.Error("expected date subtype")
+	}
+}
+
+func TestFromStruct_EditDefaults(t *testing.T) {
+	note := "hello"
+	device := &testDevice{
+		Name:    "truck",
+		Kind:    2,
+		GroupID: 7,
+		Count:   5,
+		Enabled: true,
+		Devices: []int32{3, 4},
+		Started: time.Unix(1700000000, 0),
+		Note:    &note,
+	}
+
+	fb, err := FromStruct(nil, nil, device)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, values, err := fb.BuildEdit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	checks := map[string]interface{}{
+		"name":    "truck",
+		"kind":    int32(2),
+		"grp":     int32(7),
+		"count":   5,
+		"enabled": true,
+		"started": int64(1700000000),
+		"note":    "hello",
+	}
+	for id, want := range checks {
+		if values[id] != want {
+			t.Errorf("%s: expected %v (%T), got %v (%T)", id, want, want, values[id], values[id])
+		}
+	}
+	if list, ok := values["devices"].(field.ModelListValue); !ok || len(list) != 2 {
+		t.Errorf("expected devices [3 4], got %v", values["devices"])
+	}
+}
+
+func TestFromStruct_BindInto(t *testing.T) {
+	device := &testDevice{Name: "old", Internal: "keep"}
+
+	fb, err := FromStruct(nil, nil, device)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fg, _, err := fb.BuildEdit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data := map[string]interface{}{
+		"name":    "new",
+		"kind":    float64(2),
+		"serial":  "SN-1",
+		"grp":     float64(9),
+		"count":   float64(42),
+		"enabled": true,
+		"devices": []interface{}{float64(1), float64(2)},
+		"started": "2024-01-02",
+		"note":    "text",
+	}
+	if err := BindFromMap(data, fg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := fb.BindInto(device); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if device.Name != "new" || device.Kind != 2 || device.Serial != "SN-1" || device.GroupID != 9 {
+		t.Errorf("unexpected values: %+v", device)
+	}
+	if device.Count != 42 || !device.Enabled {
+		t.Errorf("unexpected count/enabled: %d %v", device.Count, device.Enabled)
+	}
+	if len(device.Devices) != 2 || device.Devices[1] != 2 {
+		t.Errorf("unexpected devices: %v", device.Devices)
+	}
+	if !device.Started.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected started: %v", device.Started)
+	}
+	if device.Note == nil || *device.Note != "text" {
+		t.Errorf("unexpected note: %v", device.Note)
+	}
+	if device.Internal != "keep" {
+		t.Errorf("untagged field was modified: %s", device.Internal)
+	}
+}
+
+func TestFromStruct_ValidationError(t *testing.T) {
+	device := &testDevice{}
+	fb, err := FromStruct(nil, nil, device)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fg, _, _ := fb.BuildAdd()
+
+	err = BindFromMap(map[string]interface{}{"name": "x", "count": float64(101)}, fg)
+	if err == nil {
+		t.Fatal("expected validation error for count > max")
+	}
+}
+
+func TestFromStruct_InvalidInput(t *testing.T) {
+	if _, err := FromStruct(nil, nil, testDevice{}); err == nil {
+		t.Error("expected error for non-pointer")
+	}
+
+	type badTag struct {
+		Name string `xiri:"label"`
+	}
+	if _, err := FromStruct(nil, nil, &badTag{}); err == nil {
+		t.Error("expected error for invalid tag")
+	}
+
+	type badType struct {
+		Name string `xiri:"type=bool"`
+	}
+	if _, err := FromStruct(nil, nil, &badType{}); err == nil {
+		t.Error("expected error for incompatible type")
+	}
+
+	type missingOptions struct {
+		Kind int32 `xiri:"type=select,options=Nope"`
+	}
+	if _, err := FromStruct(nil, nil, &missingOptions{}); err == nil {
+		t.Error("expected error for missing options method")
+	}
+}
+
+func TestFromStruct_ScenarioAndAccess(t *testing.T) {
+	type account struct {
+		Name  string `xiri:"label=NAME"`
+		Login string `xiri:"label=LOGIN,scenario=add"`
+		Role  string `xiri:"label=ROLE,access=admin"`
+	}
+	obj := &account{Name: "old", Login: "keep", Role: "user"}
+
+	fb, err := FromStruct(nil, nil, obj)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fb.SetPermissionChecker(group.PermissionFunc(func(p string) bool { return false }))
+
+	fg, values, err := fb.BuildEdit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fg.GetFields()) != 1 || len(values) != 1 {
+		t.Fatalf("expected only name in edit scenario, got %v", fg.GetFieldIDs())
+	}
+
+	data := map[string]interface{}{"name": "new", "login": "hacked", "role": "admin"}
+	if err := BindFromMap(data, fg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := fb.BindInto(obj); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.Name != "new" || obj.Login != "keep" || obj.Role != "user" {
+		t.Errorf("expected only name to change, got %+v", obj)
+	}
+
+	fg, _, _ = fb.BuildAdd()
+	if _, ok := fg.GetField("login"); !ok {
+		t.Error("expected login in add scenario")
+	}
+}
+
+func TestBindInto_WrongType(t *testing.T) {
+	fb, err := FromStruct(nil, nil, &testDevice{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	type other struct{}
+	if err := fb.BindInto(&other{}); err == nil {
+		t.Error("expected error for different struct type")
+	}
+
+	manual := NewFormBuilder(nil, nil).AddField(field.NewTextField("name", "NAME", false, ""))
+	if err := manual.BindInto(&testDevice{}); err == nil {
+		t.Error("expected error for builder not created with FromStruct")
+	}
+}
+
+func TestFromStruct_BindIntoSkipsHiddenFields(t *testing.T) {
+	device := &testDevice{Name: "old", Serial: "SN-OLD"}
+
+	fb, err := FromStruct(nil, nil, device)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fg, _, err := fb.BuildEdit()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data := map[string]interface{}{"name": "new", "kind": float64(1), "serial": "SN-NEW"}
+	if err := BindFromMap(data, fg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := fb.BindInto(device); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if device.Name != "new" || device.Serial != "SN-OLD" {
+		t.Errorf("expected hidden serial to be kept, got %+v", device)
+	}
+}
@@ -0,0 +1,316 @@
+package builder
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xiriframework/xiri-go/component/stepper"
+	"github.com/xiriframework/xiri-go/component/url"
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+	"github.com/xiriframework/xiri-go/request"
+)
+
+// ============================================================================
+// Multi-Step Wizard
+// ============================================================================
+//
+// A Wizard splits the fields of one FormBuilder into steps by BaseField.Step
+// (fields without step belong to the first step) and renders them as a
+// stepper.Stepper. All steps post to the same URL with the step number:
+//
+//   - "next" (step < last): only the fields of the current step are validated;
+//     the response contains the fields of the next step and a signed state token
+//     with the values of all completed steps.
+//   - "done" (last step): the values of all steps are bound and validated
+//     like BindAndValidate, with errors reported for any step.
+//
+// The state token is sent back as a hidden field, so earlier values don't have
+// to be posted again. It is signed with the wizard secret (HMAC-SHA256) and
+// expires after TTL; it is not encrypted, so it must not carry secrets.
+// The token is not bound to a user or session: anyone holding a valid token can
+// continue the wizard with its values until it expires, so the final step must
+// check permissions like any other save request.
+// File uploads are not carried between steps.
+
+// Request parameters of wizard steps
+const (
+	WizardStepParam  = "step"        // Current step number (sent by the stepper as extra data)
+	WizardStateParam = "wizardState" // Signed state token of the completed steps
+)
+
+// DefaultWizardTTL is the lifetime of a wizard state token.
+const DefaultWizardTTL = time.Hour
+
+// MinWizardSecretSize is the minimum length of the wizard secret in bytes.
+const MinWizardSecretSize = 32
+
+// ErrWizardState is returned for missing, invalid or expired wizard state tokens.
+var ErrWizardState = errors.New("invalid wizard state")
+
+// Wizard is a multi-step form backed by a single FormBuilder.
+type Wizard struct {
+	id     string
+	fb     *FormBuilder
+	titles []string
+	secret []byte
+	TTL    time.Duration // Lifetime of state tokens (default DefaultWizardTTL)
+}
+
+// WizardResult is the result of a wizard step request.
+type WizardResult struct {
+	Step int                  // Step whose values were submitted
+	Done bool                 // True after the last step: all field values are bound
+	Next *stepper.StepperStep // Fields of the next step, including the state token (nil if Done)
+}
+
+// wizardState is the signed payload of a state token.
+type wizardState struct {
+	Wizard  string                 `json:"w"`
+	Step    int                    `json:"s"` // Last completed step
+	Expires int64                  `json:"e"`
+	Values  map[string]interface{} `json:"v"` // Raw values of the completed steps
+}
+
+// NewWizard creates a wizard with one step per title. The id distinguishes the state
+// tokens of different wizards signed with the same secret. The secret must have at
+// least MinWizardSecretSize random bytes.
+//
+// Example:
+//
+//	fb := builder.NewFormBuilder(ctx, t).
+//	    AddField(field.NewTextField("name", "NAME", true, "").SetStep(1)).
+//	    AddField(field.NewModelField("grp", "GRUPPE", true, "Group", 0).SetStep(2))
+//	wizard, err := builder.NewWizard("device-add", fb, []string{"GENERAL", "GROUP"}, secret)
+//
+//	// Page: render the stepper
+//	s, err := wizard.Stepper(url.NewUrl("/Portal/Device/AddSave"), "BACK", "NEXT", "DONE")
+//
+//	// AddSave: validate the step, continue or save
+//	result, err := wizard.Handle(echoadapter.Source(c))
+//	if err != nil {
+//	    return wc.BadRequest(err.Error())
+//	}
+//	if !result.Done {
+//	    return c.JSON(http.StatusOK, result.Next.Print(t))
+//	}
+//	// all field values are bound: name.Value, grp.Value
+func NewWizard(id string, fb *FormBuilder, titles []string, secret []byte) (*Wizard, error) {
+	if len(secret) < MinWizardSecretSize {
+		return nil, fmt.Errorf("wizard %s: secret must have at least %d bytes, got %d", id, MinWizardSecretSize, len(secret))
+	}
+	return &Wizard{
+		id:     id,
+		fb:     fb,
+		titles: titles,
+		secret: secret,
+		TTL:    DefaultWizardTTL,
+	}, nil
+}
+
+// Steps returns the number of steps.
+func (w *Wizard) Steps() int {
+	return len(w.titles)
+}
+
+// Stepper builds the stepper component with the fields of every step (Add workflow).
+func (w *Wizard) Stepper(u *url.Url, textBack, textNext, textDone string) (*stepper.Stepper, error) {
+	fg, values, err := w.fb.BuildAdd()
+	if err != nil {
+		return nil, err
+	}
+	steps, err := w.partition(fg)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := fg.ExportForFrontendWithValues(values)
+	fields := make([][]map[string]any, w.Steps())
+	for i := range fields {
+		fields[i] = []map[string]any{}
+	}
+	for i, f := range fg.GetFields() {
+		step := steps[f.GetID()]
+		fields[step-1] = append(fields[step-1], exported[i])
+	}
+
+	return stepper.NewStepper(u, w.Steps(), w.titles, fields, textBack, textNext, textDone, nil)
+}
+
+// Handle processes a step request: the current step is validated on "next", all steps
+// on "done". Validation failures are returned as group.ValidationErrors; invalid state
+// tokens as ErrWizardState.
+func (w *Wizard) Handle(src request.Source) (*WizardResult, error) {
+	fg, _, err := w.fb.BuildAdd()
+	if err != nil {
+		return nil, err
+	}
+	ids := append(fg.GetFieldIDs(), WizardStepParam, WizardStateParam)
+	data, err := requestFormData(src, ids)
+	if err != nil {
+		return nil, err
+	}
+	return w.HandleValues(fg, data)
+}
+
+// HandleValues processes a step request from already extracted values (see Handle).
+// data contains the submitted field values, the step number and the state token.
+func (w *Wizard) HandleValues(fg *group.FormGroup, data map[string]interface{}) (*WizardResult, error) {
+	steps, err := w.partition(fg)
+	if err != nil {
+		return nil, err
+	}
+
+	step, err := stepNumber(data[WizardStepParam])
+	if err != nil || step < 1 || step > w.Steps() {
+		return nil, fmt.Errorf("invalid wizard step %v", data[WizardStepParam])
+	}
+
+	// Values of the completed steps from the token, then the submitted step
+	values := make(map[string]interface{})
+	if step > 1 {
+		token, _ := data[WizardStateParam].(string)
+		state, err := w.verify(token)
+		if err != nil {
+			return nil, err
+		}
+		if state.Step < step-1 {
+			return nil, fmt.Errorf("%w: step %d not completed", ErrWizardState, step-1)
+		}
+		for id, value := range state.Values {
+			if steps[id] > 0 && steps[id] < step {
+				values[id] = value
+			}
+		}
+	}
+	for _, f := range fg.GetFields() {
+		if value, exists := data[f.GetID()]; exists && steps[f.GetID()] == step {
+			values[f.GetID()] = value
+		}
+	}
+
+	// Done: bind and validate everything
+	if step == w.Steps() {
+		if err := BindFromMap(values, fg); err != nil {
+			return nil, err
+		}
+		return &WizardResult{Step: step, Done: true}, nil
+	}
+
+	// Next: validate the current step only
+	_, err = fg.ParseAndValidate(values)
+	if verrs, ok := group.AsValidationErrors(err); ok {
+		var stepErrs group.ValidationErrors
+		for _, e := range verrs {
+			if steps[strings.SplitN(e.Field, ".", 2)[0]] == step {
+				stepErrs = append(stepErrs, e)
+			}
+		}
+		if len(stepErrs) > 0 {
+			return nil, stepErrs
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	token, err := w.sign(wizardState{Wizard: w.id, Step: step, Values: values})
+	if err != nil {
+		return nil, err
+	}
+	return &WizardResult{Step: step, Next: w.nextStep(fg, steps, step+1, values, token)}, nil
+}
+
+// nextStep exports the fields of a step with the state token as hidden field.
+func (w *Wizard) nextStep(fg *group.FormGroup, steps map[string]int, step int, values map[string]interface{}, token string) *stepper.StepperStep {
+	exported := fg.ExportForFrontendWithValues(values)
+	fields := make([]map[string]any, 0)
+	for i, f := range fg.GetFields() {
+		if steps[f.GetID()] == step {
+			fields = append(fields, exported[i])
+		}
+	}
+	state := field.NewTextField(WizardStateParam, "", false, token).SetForm(false)
+	fields = append(fields, state.ExportForFrontend(fg.GetContext(), token))
+	return stepper.NewStepperStep(step, fields)
+}
+
+// partition returns the step of every active field (fields without step are in step 1).
+func (w *Wizard) partition(fg *group.FormGroup) (map[string]int, error) {
+	steps := make(map[string]int, len(fg.GetFields()))
+	for _, f := range fg.GetFields() {
+		step := 1
+		if s, ok := f.(interface{ GetStep() int }); ok && s.GetStep() > 0 {
+			step = s.GetStep()
+		}
+		if step > w.Steps() {
+			return nil, fmt.Errorf("field %s has step %d, wizard has %d steps", f.GetID(), step, w.Steps())
+		}
+		steps[f.GetID()] = step
+	}
+	return steps, nil
+}
+
+// sign encodes and signs a state token: base64url(payload) "." base64url(HMAC-SHA256).
+func (w *Wizard) sign(state wizardState) (string, error) {
+	state.Expires = time.Now().Add(w.TTL).Unix()
+	payload, err := json.Marshal(state)
+	if err != nil {
+		return "", fmt.Errorf("encoding wizard state: %w", err)
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(w.mac(encoded)), nil
+}
+
+// verify checks the signature, wizard and expiry of a state token and decodes it.
+func (w *Wizard) verify(token string) (*wizardState, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, fmt.Errorf("%w: malformed token", ErrWizardState)
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(sig, w.mac(encoded)) {
+		return nil, fmt.Errorf("%w: bad signature", ErrWizardState)
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed token", ErrWizardState)
+	}
+	var state wizardState
+	if err := json.Unmarshal(payload, &state); err != nil {
+		return nil, fmt.Errorf("%w: malformed token", ErrWizardState)
+	}
+	if state.Wizard != w.id {
+		return nil, fmt.Errorf("%w: token of another wizard", ErrWizardState)
+	}
+	if time.Now().Unix() > state.Expires {
+		return nil, fmt.Errorf("%w: token expired", ErrWizardState)
+	}
+	return &state, nil
+}
+
+// mac returns the HMAC-SHA256 of the encoded payload.
+func (w *Wizard) mac(encoded string) []byte {
+	h := hmac.New(sha256.New, w.secret)
+	h.Write([]byte(encoded))
+	return h.Sum(nil)
+}
+
+// stepNumber parses the step number (JSON number or string).
+func stepNumber(raw interface{}) (int, error) {
+	switch v := raw.(type) {
+	case float64:
+		return int(v), nil
+	case int:
+		return v, nil
+	case string:
+		return strconv.Atoi(v)
+	}
+	return 0, fmt.Errorf("invalid step %v", raw)
+}
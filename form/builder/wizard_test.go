@@ -0,0 +1,183 @@
+package builder
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/xiriframework/xiri-go/component/url"
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/form/group"
+)
+
+var testWizardSecret = []byte("test-secret-with-at-least-32-bytes")
+
+// mustNewWizard creates a wizard with a valid secret for tests
+func mustNewWizard(id string, fb *FormBuilder, titles []string, secret []byte) *Wizard {
+	wizard, err := NewWizard(id, fb, titles, secret)
+	if err != nil {
+		panic(err)
+	}
+	return wizard
+}
+
+func newTestWizard() (*Wizard, *field.TextField, *field.IntField) {
+	name := field.NewTextFieldWithLength("name", "NAME", true, "", 0, 10).SetStep(1)
+	count := field.NewIntField("count", "COUNT", true, 0).SetStep(2)
+	fb := NewFormBuilder(nil, nil).AddField(name).AddField(count)
+	return mustNewWizard("test", fb, []string{"GENERAL", "DETAILS"}, testWizardSecret), name, count
+}
+
+// stateToken returns the state token of the hidden field in a step result.
+func stateToken(t *testing.T, result *WizardResult) string {
+	t.Helper()
+	fields := result.Next.Print(nil)["fields"].([]map[string]any)
+	for _, f := range fields {
+		if f["id"] == WizardStateParam {
+			return f["value"].(string)
+		}
+	}
+	t.Fatal("state token field missing")
+	return ""
+}
+
+func TestWizard_Stepper(t *testing.T) {
+	wizard, _, _ := newTestWizard()
+
+	s, err := wizard.Stepper(url.NewUrl("/wizard"), "BACK", "NEXT", "DONE")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	steps := s.Print(nil)["data"].(map[string]any)["steps"].([]map[string]any)
+	if len(steps) != 2 {
+		t.Fatalf("expected 2 steps, got %d", len(steps))
+	}
+	for i, id := range []string{"name", "count"} {
+		fields := steps[i]["fields"].([]map[string]any)
+		if len(fields) != 1 || fields[0]["id"] != id {
+			t.Errorf("step %d: expected field %s, got %v", i+1, id, fields)
+		}
+	}
+}
+
+func TestWizard_ShortSecret(t *testing.T) {
+	if _, err := NewWizard("test", NewFormBuilder(nil, nil), []string{"A"}, []byte("secret")); err == nil {
+		t.Error("expected error for secret shorter than MinWizardSecretSize")
+	}
+}
+
+func TestWizard_StepOutOfRange(t *testing.T) {
+	name := field.NewTextField("name", "NAME", true, "").SetStep(3)
+	wizard := mustNewWizard("test", NewFormBuilder(nil, nil).AddField(name), []string{"A", "B"}, testWizardSecret)
+
+	if _, err := wizard.Stepper(url.NewUrl("/wizard"), "BACK", "NEXT", "DONE"); err == nil {
+		t.Fatal("expected error for field step beyond the last step")
+	}
+}
+
+func TestWizard_NextValidatesCurrentStepOnly(t *testing.T) {
+	wizard, _, _ := newTestWizard()
+	fg, _, _ := wizard.fb.BuildAdd()
+
+	// Required count of step 2 is not reported on step 1
+	result, err := wizard.HandleValues(fg, map[string]interface{}{"step": "1", "name": "Truck"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Done || result.Next == nil {
+		t.Fatal("expected next step")
+	}
+	if step := result.Next.Print(nil)["step"]; step != 2 {
+		t.Errorf("expected next step 2, got %v", step)
+	}
+
+	// Invalid name fails step 1
+	_, err = wizard.HandleValues(fg, map[string]interface{}{"step": "1", "name": "A very long name"})
+	verrs, ok := group.AsValidationErrors(err)
+	if !ok || len(verrs) != 1 || verrs[0].Field != "name" {
+		t.Errorf("expected validation error for name, got %v", err)
+	}
+}
+
+func TestWizard_DoneBindsAllSteps(t *testing.T) {
+	wizard, name, count := newTestWizard()
+	fg, _, _ := wizard.fb.BuildAdd()
+
+	result, err := wizard.HandleValues(fg, map[string]interface{}{"step": "1", "name": "Truck"})
+	if err != nil {
+		t.Fatalf("step 1: unexpected error: %v", err)
+	}
+
+	// Step 2 posts only its own values and the token
+	result, err = wizard.HandleValues(fg, map[string]interface{}{
+		"step":           float64(2),
+		"count":          float64(4),
+		WizardStateParam: stateToken(t, result),
+	})
+	if err != nil {
+		t.Fatalf("step 2: unexpected error: %v", err)
+	}
+	if !result.Done {
+		t.Fatal("expected done")
+	}
+	if name.Value == nil || *name.Value != "Truck" {
+		t.Errorf("expected name 'Truck' from state, got %v", name.Value)
+	}
+	if count.Value == nil || *count.Value != 4 {
+		t.Errorf("expected count 4, got %v", count.Value)
+	}
+}
+
+func TestWizard_StateIgnoresResubmittedEarlierValues(t *testing.T) {
+	wizard, name, _ := newTestWizard()
+	fg, _, _ := wizard.fb.BuildAdd()
+
+	result, _ := wizard.HandleValues(fg, map[string]interface{}{"step": "1", "name": "Truck"})
+	_, err := wizard.HandleValues(fg, map[string]interface{}{
+		"step":           "2",
+		"name":           "Tampered",
+		"count":          "4",
+		WizardStateParam: stateToken(t, result),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name.Value == nil || *name.Value != "Truck" {
+		t.Errorf("expected signed name 'Truck', got %v", name.Value)
+	}
+}
+
+func TestWizard_InvalidState(t *testing.T) {
+	wizard, _, _ := newTestWizard()
+	fg, _, _ := wizard.fb.BuildAdd()
+
+	result, _ := wizard.HandleValues(fg, map[string]interface{}{"step": "1", "name": "Truck"})
+	token := stateToken(t, result)
+
+	other := mustNewWizard("other", wizard.fb, wizard.titles, testWizardSecret)
+	expired := mustNewWizard("test", wizard.fb, wizard.titles, testWizardSecret)
+	expired.TTL = -time.Minute
+	expiredResult, _ := expired.HandleValues(fg, map[string]interface{}{"step": "1", "name": "Truck"})
+
+	tests := []struct {
+		name   string
+		wizard *Wizard
+		token  string
+	}{
+		{"missing", wizard, ""},
+		{"tampered", wizard, "x" + token},
+		{"other secret", mustNewWizard("test", wizard.fb, wizard.titles, []byte("other-secret-with-at-least-32-bytes")), token},
+		{"other wizard", other, token},
+		{"expired", wizard, stateToken(t, expiredResult)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.wizard.HandleValues(fg, map[string]interface{}{
+				"step": "2", "count": "4", WizardStateParam: tt.token,
+			})
+			if !errors.Is(err, ErrWizardState) {
+				t.Errorf("expected ErrWizardState, got %v", err)
+			}
+		})
+	}
+}
@@ -29,6 +29,9 @@ const (
 	FieldTypeTimelimit  FieldType = "timelimit" // Time limit field with weekdays and time range (17th field type)
 	FieldTypeChips      FieldType = "chips"     // Tag/chip input field (18th field type)
 	FieldTypeDivider    FieldType = "divider"   // Visual divider/separator (19th field type)
+	FieldTypeDecimal    FieldType = "decimal"   // Decimal number with precision/scale (20th field type)
+	FieldTypeMoney      FieldType = "money"     // Money amount stored in minor units (21st field type)
+	FieldTypeRepeater   FieldType = "repeater"  // Repeatable nested sub-form (22nd field type, see group.RepeaterField)
 )
 
 // FormField is the base interface that all form fields must implement
@@ -75,6 +78,13 @@ type FieldOptionsLoader interface {
 	LoadOptions(ctx *uicontext.UiContext) error
 }
 
+// ContextBinder is an interface for fields that depend on the user context for
+// parsing (e.g., Time and TimeRange fields use the user's timezone).
+// FormGroup calls BindContext when its context is set.
+type ContextBinder interface {
+	BindContext(ctx *uicontext.UiContext)
+}
+
 // BaseField provides common functionality for all form fields
 type BaseField struct {
 	ID       string
@@ -89,8 +99,11 @@ type BaseField struct {
 	Step     int    // Step indicator for multi-step forms (0 = no step)
 	Disabled bool   // Whether the field is disabled
 
-	// Conditional visibility
-	ShowWhen []Condition // Conditions that must all be true for the field to be visible
+	// Conditional visibility and state
+	ShowWhen     []Condition   // Conditions that must all be true for the field to be visible
+	ShowWhenExpr ConditionExpr // Composite visibility condition, combined with ShowWhen (AND)
+	DisabledWhen ConditionExpr // While true the field is disabled and its value is ignored
+	RequiredWhen ConditionExpr // While true the field is required
 
 	// Advanced options
 	Access   []string // Access control permissions (nil = no restriction)
@@ -123,6 +136,64 @@ func (f *BaseField) GetForm() bool {
 	return f.Form
 }
 
+// GetStep returns the step indicator for multi-step forms (0 = no step)
+func (f *BaseField) GetStep() int {
+	return f.Step
+}
+
+// GetShowWhen returns the visibility conditions (nil = always visible)
+func (f *BaseField) GetShowWhen() []Condition {
+	return f.ShowWhen
+}
+
+// GetShowWhenExpr returns the complete visibility condition, ShowWhen and ShowWhenExpr
+// combined (nil = always visible)
+func (f *BaseField) GetShowWhenExpr() ConditionExpr {
+	switch {
+	case f.ShowWhenExpr == nil && len(f.ShowWhen) == 0:
+		return nil
+	case f.ShowWhenExpr == nil:
+		return AllOf(f.ShowWhen)
+	case len(f.ShowWhen) == 0:
+		return f.ShowWhenExpr
+	}
+	return append(AllOf(f.ShowWhen), f.ShowWhenExpr)
+}
+
+// GetDisabledWhen returns the condition under which the field is disabled (nil = never)
+func (f *BaseField) GetDisabledWhen() ConditionExpr {
+	return f.DisabledWhen
+}
+
+// GetRequiredWhen returns the condition under which the field is required (nil = never)
+func (f *BaseField) GetRequiredWhen() ConditionExpr {
+	return f.RequiredWhen
+}
+
+// GetScenario returns the scenarios this field applies to (nil = all scenarios)
+func (f *BaseField) GetScenario() []string {
+	return f.Scenario
+}
+
+// GetAccess returns the access control permissions (nil = no restriction)
+func (f *BaseField) GetAccess() []string {
+	return f.Access
+}
+
+// AppliesToScenario returns true if the field is part of the given scenario.
+// Fields without Scenario apply to all scenarios; an empty scenario matches all fields.
+func (f *BaseField) AppliesToScenario(scenario string) bool {
+	if scenario == "" || len(f.Scenario) == 0 {
+		return true
+	}
+	for _, s := range f.Scenario {
+		if s == scenario {
+			return true
+		}
+	}
+	return false
+}
+
 // GetBaseExport returns common export fields for all field types
 func (f *BaseField) GetBaseExport(ctx *uicontext.UiContext, value interface{}) map[string]interface{} {
 	result := map[string]interface{}{
@@ -153,13 +224,21 @@ func (f *BaseField) GetBaseExport(ctx *uicontext.UiContext, value interface{}) m
 	}
 
 	// Add showWhen conditions if any
-	if len(f.ShowWhen) > 0 {
+	if f.ShowWhenExpr != nil {
+		result["showWhen"] = f.GetShowWhenExpr()
+	} else if len(f.ShowWhen) > 0 {
 		if len(f.ShowWhen) == 1 {
 			result["showWhen"] = f.ShowWhen[0]
 		} else {
 			result["showWhen"] = f.ShowWhen
 		}
 	}
+	if f.DisabledWhen != nil {
+		result["disabledWhen"] = f.DisabledWhen
+	}
+	if f.RequiredWhen != nil {
+		result["requiredWhen"] = f.RequiredWhen
+	}
 
 	return result
 }
@@ -216,6 +295,32 @@ func (f *BaseField) SetShowWhen(field string, operator ConditionOperator, value
 	return f
 }
 
+// SetShowWhenExpr sets a composite visibility condition (combined with ShowWhen)
+//
+// Example:
+//
+//	f.SetShowWhenExpr(field.And(
+//	    field.NewCondition("type", field.CondIn, []int{2, 3}),
+//	    field.Not(field.NewCondition("archived", field.CondEquals, true)),
+//	))
+func (f *BaseField) SetShowWhenExpr(expr ConditionExpr) *BaseField {
+	f.ShowWhenExpr = expr
+	return f
+}
+
+// SetDisabledWhen disables the field while the condition is true.
+// The server ignores values sent for a disabled field.
+func (f *BaseField) SetDisabledWhen(expr ConditionExpr) *BaseField {
+	f.DisabledWhen = expr
+	return f
+}
+
+// SetRequiredWhen makes the field required while the condition is true
+func (f *BaseField) SetRequiredWhen(expr ConditionExpr) *BaseField {
+	f.RequiredWhen = expr
+	return f
+}
+
 // SetShowWhenNotEmpty adds a "notEmpty" visibility condition for this field
 func (f *BaseField) SetShowWhenNotEmpty(field string) *BaseField {
 	f.ShowWhen = append(f.ShowWhen, NewConditionNotEmpty(field))
@@ -1,5 +1,10 @@
 package field
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // ConditionOperator defines the comparison operators for field conditions
 type ConditionOperator string
 
@@ -11,11 +16,16 @@ const (
 	CondLess      ConditionOperator = "lessThan"
 	CondIn        ConditionOperator = "in"
 	CondNotEmpty  ConditionOperator = "notEmpty"
+
+	CondBetween       ConditionOperator = "between"       // Value is [min, max], inclusive
+	CondStartsWith    ConditionOperator = "startsWith"    // Case-insensitive prefix
+	CondRegex         ConditionOperator = "regex"         // Value is a regular expression (not anchored)
+	CondLengthGreater ConditionOperator = "lengthGreater" // Length of a string or list is greater than Value
 )
 
 // Condition represents a visibility condition for a form field.
 // When set on a field, the frontend will show/hide the field based on the value
-// of another field in the same form.
+// of another field in the same form. Condition is the leaf of a ConditionExpr.
 type Condition struct {
 	Field    string            `json:"field"`
 	Operator ConditionOperator `json:"operator"`
@@ -38,3 +48,224 @@ func NewConditionNotEmpty(field string) Condition {
 		Operator: CondNotEmpty,
 	}
 }
+
+// NewConditionBetween creates a "between" condition (min <= value <= max)
+func NewConditionBetween(field string, min, max interface{}) Condition {
+	return Condition{
+		Field:    field,
+		Operator: CondBetween,
+		Value:    []interface{}{min, max},
+	}
+}
+
+// ============================================================================
+// Condition Expressions (AND / OR / NOT)
+// ============================================================================
+//
+// Conditions can be combined into expression trees. The JSON schema sent to
+// the frontend is stable:
+//
+//	{"field": "type", "operator": "equals", "value": 2}   // Condition
+//	{"and": [<expr>, ...]}                                  // all must hold
+//	{"or": [<expr>, ...]}                                   // at least one must hold
+//	{"not": <expr>}                                         // negation
+//
+// Example: (type == 2 OR type == 3) AND NOT archived
+//
+//	field.And(
+//	    field.Or(
+//	        field.NewCondition("type", field.CondEquals, 2),
+//	        field.NewCondition("type", field.CondEquals, 3),
+//	    ),
+//	    field.Not(field.NewCondition("archived", field.CondEquals, true)),
+//	)
+//
+// Field IDs refer to fields of the same form (FormGroup). References across forms
+// are not supported: the conditions of a repeater row template only see the fields
+// of the row, not the fields of the form containing the repeater.
+
+// ConditionExpr is a condition expression: a Condition or an And/Or/Not group.
+type ConditionExpr interface {
+	// Evaluate returns true if the expression holds for the given form values.
+	Evaluate(values map[string]interface{}) bool
+
+	// Fields returns the IDs of all fields the expression reads.
+	Fields() []string
+}
+
+// Fields returns the field the condition reads.
+func (c Condition) Fields() []string {
+	return []string{c.Field}
+}
+
+// AndCondition holds if all expressions hold (an empty group holds).
+type AndCondition []ConditionExpr
+
+// OrCondition holds if at least one expression holds (an empty group does not hold).
+type OrCondition []ConditionExpr
+
+// NotCondition holds if the wrapped expression does not hold.
+type NotCondition struct {
+	Expr ConditionExpr
+}
+
+// And combines expressions with a logical AND
+func And(exprs ...ConditionExpr) AndCondition {
+	return AndCondition(exprs)
+}
+
+// Or combines expressions with a logical OR
+func Or(exprs ...ConditionExpr) OrCondition {
+	return OrCondition(exprs)
+}
+
+// Not negates an expression
+func Not(expr ConditionExpr) NotCondition {
+	return NotCondition{Expr: expr}
+}
+
+// AllOf converts flat conditions (like ShowWhen) into an AND expression.
+func AllOf(conditions []Condition) AndCondition {
+	exprs := make(AndCondition, len(conditions))
+	for i, c := range conditions {
+		exprs[i] = c
+	}
+	return exprs
+}
+
+func (e AndCondition) Evaluate(values map[string]interface{}) bool {
+	for _, expr := range e {
+		if !expr.Evaluate(values) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e AndCondition) Fields() []string {
+	return collectFields(e)
+}
+
+func (e AndCondition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string][]ConditionExpr{"and": nonNilExprs(e)})
+}
+
+func (e OrCondition) Evaluate(values map[string]interface{}) bool {
+	for _, expr := range e {
+		if expr.Evaluate(values) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e OrCondition) Fields() []string {
+	return collectFields(e)
+}
+
+func (e OrCondition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string][]ConditionExpr{"or": nonNilExprs(e)})
+}
+
+func (e NotCondition) Evaluate(values map[string]interface{}) bool {
+	return e.Expr != nil && !e.Expr.Evaluate(values)
+}
+
+func (e NotCondition) Fields() []string {
+	if e.Expr == nil {
+		return nil
+	}
+	return e.Expr.Fields()
+}
+
+func (e NotCondition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]ConditionExpr{"not": e.Expr})
+}
+
+// ParseConditionExpr parses the JSON form of a condition expression.
+// A JSON array of expressions is read as an AND group (the flat showWhen format).
+//
+// Example:
+//
+//	expr, err := field.ParseConditionExpr([]byte(`{"or": [{"field": "type", "operator": "in", "value": [2, 3]}]}`))
+func ParseConditionExpr(data []byte) (ConditionExpr, error) {
+	var list []json.RawMessage
+	if err := json.Unmarshal(data, &list); err == nil {
+		return parseConditionList(list, And)
+	}
+
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("invalid condition expression: %w", err)
+	}
+	if raw, ok := obj["and"]; ok {
+		return parseConditionGroup(raw, And)
+	}
+	if raw, ok := obj["or"]; ok {
+		return parseConditionGroup(raw, Or)
+	}
+	if raw, ok := obj["not"]; ok {
+		inner, err := ParseConditionExpr(raw)
+		if err != nil {
+			return nil, err
+		}
+		return Not(inner), nil
+	}
+
+	var c Condition
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("invalid condition: %w", err)
+	}
+	if c.Field == "" || c.Operator == "" {
+		return nil, fmt.Errorf("invalid condition: field and operator are required")
+	}
+	return c, nil
+}
+
+// parseConditionGroup parses the member list of an and/or group.
+func parseConditionGroup[T ConditionExpr](raw json.RawMessage, combine func(...ConditionExpr) T) (ConditionExpr, error) {
+	var list []json.RawMessage
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil, fmt.Errorf("invalid condition group: %w", err)
+	}
+	return parseConditionList(list, combine)
+}
+
+// parseConditionList parses each member and combines the results.
+func parseConditionList[T ConditionExpr](list []json.RawMessage, combine func(...ConditionExpr) T) (ConditionExpr, error) {
+	exprs := make([]ConditionExpr, len(list))
+	for i, raw := range list {
+		expr, err := ParseConditionExpr(raw)
+		if err != nil {
+			return nil, err
+		}
+		exprs[i] = expr
+	}
+	return combine(exprs...), nil
+}
+
+// collectFields returns the distinct fields read by the expressions.
+func collectFields(exprs []ConditionExpr) []string {
+	var fields []string
+	seen := make(map[string]bool)
+	for _, expr := range exprs {
+		if expr == nil {
+			continue
+		}
+		for _, f := range expr.Fields() {
+			if !seen[f] {
+				seen[f] = true
+				fields = append(fields, f)
+			}
+		}
+	}
+	return fields
+}
+
+// nonNilExprs returns the expressions as a non-nil slice (so empty groups marshal as []).
+func nonNilExprs(exprs []ConditionExpr) []ConditionExpr {
+	if exprs == nil {
+		return []ConditionExpr{}
+	}
+	return exprs
+}
@@ -0,0 +1,251 @@
+package field
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// ============================================================================
+// Server-Side Comparison for Condition Operators
+// ============================================================================
+
+// Evaluate returns true if the condition holds for the given form values.
+//
+// Example:
+//
+//	field.NewCondition("type", field.CondEquals, 2).Evaluate(values)
+func (c Condition) Evaluate(values map[string]interface{}) bool {
+	return CompareValues(values[c.Field], c.Operator, c.Value)
+}
+
+// EvaluateConditions returns true if all conditions hold (empty = true), like ShowWhen in the frontend.
+func EvaluateConditions(conditions []Condition, values map[string]interface{}) bool {
+	for _, c := range conditions {
+		if !c.Evaluate(values) {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareValues applies a condition operator to a field value a and an operand b,
+// with the same semantics as the frontend:
+//   - numbers compare numerically regardless of type (int, int32, float64, ...)
+//   - time.Time values compare chronologically
+//   - contains: substring for strings, element for lists
+//   - in: a is an element of the list b
+//   - notEmpty: a is not nil, "", false, zero number or an empty list (b is ignored)
+//   - between: b is a [min, max] list, bounds inclusive
+//   - startsWith: case-insensitive string prefix
+//   - regex: b is a regular expression matched anywhere in a (invalid patterns never match)
+//   - lengthGreater: rune count of a string or length of a list is greater than b
+//
+// Example:
+//
+//	field.CompareValues(values["end"], field.CondGreater, values["start"])
+func CompareValues(a interface{}, op ConditionOperator, b interface{}) bool {
+	switch op {
+	case CondEquals:
+		return valuesEqual(a, b)
+	case CondNotEquals:
+		return !valuesEqual(a, b)
+	case CondGreater:
+		c, ok := compareOrdered(a, b)
+		return ok && c > 0
+	case CondLess:
+		c, ok := compareOrdered(a, b)
+		return ok && c < 0
+	case CondContains:
+		if s, ok := a.(string); ok {
+			return strings.Contains(strings.ToLower(s), strings.ToLower(fmt.Sprint(b)))
+		}
+		return listContains(a, b)
+	case CondIn:
+		return listContains(b, a)
+	case CondNotEmpty:
+		return !IsEmptyValue(a)
+	case CondBetween:
+		return between(a, b)
+	case CondStartsWith:
+		s, ok := a.(string)
+		return ok && strings.HasPrefix(strings.ToLower(s), strings.ToLower(fmt.Sprint(b)))
+	case CondRegex:
+		if a == nil {
+			return false
+		}
+		re, err := conditionRegex(fmt.Sprint(b))
+		return err == nil && re.MatchString(fmt.Sprint(a))
+	case CondLengthGreater:
+		n, ok := toFloat(b)
+		return ok && float64(valueLength(a)) > n
+	}
+	return false
+}
+
+// conditionRegexCache caches compiled regex condition operands (pattern -> *regexp.Regexp).
+var conditionRegexCache sync.Map
+
+// conditionRegex returns the compiled regex for a regex condition, compiling it once.
+func conditionRegex(pattern string) (*regexp.Regexp, error) {
+	if re, ok := conditionRegexCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	conditionRegexCache.Store(pattern, re)
+	return re, nil
+}
+
+// between returns true if bounds is a [min, max] list and min <= v <= max.
+func between(v, bounds interface{}) bool {
+	if bounds == nil {
+		return false
+	}
+	rv := reflect.ValueOf(bounds)
+	if (rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array) || rv.Len() != 2 {
+		return false
+	}
+	lower, ok := compareOrdered(v, rv.Index(0).Interface())
+	if !ok || lower < 0 {
+		return false
+	}
+	upper, ok := compareOrdered(v, rv.Index(1).Interface())
+	return ok && upper <= 0
+}
+
+// valueLength returns the rune count of strings and the length of lists (0 otherwise).
+func valueLength(v interface{}) int {
+	if s, ok := v.(string); ok {
+		return utf8.RuneCountInString(s)
+	}
+	if v == nil {
+		return 0
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return rv.Len()
+	}
+	return 0
+}
+
+// IsEmptyValue returns true for nil, "", false, zero numbers, zero times and empty lists/maps.
+func IsEmptyValue(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	if t, ok := v.(time.Time); ok {
+		return t.IsZero()
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Array, reflect.String:
+		return rv.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return rv.IsNil()
+	}
+	return rv.IsZero()
+}
+
+// valuesEqual compares two values, treating all numeric types as numbers.
+func valuesEqual(a, b interface{}) bool {
+	if an, ok := toFloat(a); ok {
+		if bn, ok := toFloat(b); ok {
+			return an == bn
+		}
+	}
+	if at, ok := a.(time.Time); ok {
+		if bt, ok := b.(time.Time); ok {
+			return at.Equal(bt)
+		}
+	}
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	// Strings vs. other scalars (e.g. "2" from form data vs. 2 in the condition)
+	if a == nil || b == nil {
+		return false
+	}
+	return fmt.Sprint(a) == fmt.Sprint(b)
+}
+
+// compareOrdered compares numbers, times and strings. ok is false for incomparable values.
+func compareOrdered(a, b interface{}) (int, bool) {
+	if an, ok := toFloat(a); ok {
+		if bn, ok := toFloat(b); ok {
+			switch {
+			case an < bn:
+				return -1, true
+			case an > bn:
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if at, ok := a.(time.Time); ok {
+		if bt, ok := b.(time.Time); ok {
+			return at.Compare(bt), true
+		}
+	}
+	if as, ok := a.(string); ok {
+		if bs, ok := b.(string); ok {
+			return strings.Compare(as, bs), true
+		}
+	}
+	return 0, false
+}
+
+// listContains returns true if list is a slice or array containing an element equal to v.
+func listContains(list, v interface{}) bool {
+	if list == nil {
+		return false
+	}
+	rv := reflect.ValueOf(list)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return false
+	}
+	for i := range rv.Len() {
+		if valuesEqual(rv.Index(i).Interface(), v) {
+			return true
+		}
+	}
+	return false
+}
+
+// toFloat converts numeric values to float64.
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
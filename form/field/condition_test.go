@@ -0,0 +1,139 @@
+package field
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestCompareValues(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		a    interface{}
+		op   ConditionOperator
+		b    interface{}
+		want bool
+	}{
+		{"equals mixed numbers", int32(2), CondEquals, float64(2), true},
+		{"equals string vs number", "2", CondEquals, 2, true},
+		{"equals different", "a", CondEquals, "b", false},
+		{"notEquals", 1, CondNotEquals, 2, true},
+		{"greater int64", int64(10), CondGreater, 5, true},
+		{"greater time", now.Add(time.Hour), CondGreater, now, true},
+		{"greater incomparable", "a", CondGreater, 1, false},
+		{"less", 1.5, CondLess, 2, true},
+		{"contains string", "Hello World", CondContains, "world", true},
+		{"contains list", []int32{1, 2, 3}, CondContains, 2, true},
+		{"in", 3, CondIn, []interface{}{float64(1), float64(3)}, true},
+		{"in missing", 4, CondIn, []int{1, 3}, false},
+		{"notEmpty string", "x", CondNotEmpty, nil, true},
+		{"notEmpty empty list", ModelListValue{}, CondNotEmpty, nil, false},
+		{"notEmpty nil", nil, CondNotEmpty, nil, false},
+		{"between inside", int32(5), CondBetween, []interface{}{1, 10}, true},
+		{"between bound", float64(10), CondBetween, []int{1, 10}, true},
+		{"between outside", 11, CondBetween, []int{1, 10}, false},
+		{"between invalid bounds", 5, CondBetween, 10, false},
+		{"startsWith", "Truck 12", CondStartsWith, "truck", true},
+		{"startsWith no match", "Car", CondStartsWith, "truck", false},
+		{"regex", "AB-1234", CondRegex, `^[A-Z]{2}-\d+$`, true},
+		{"regex no match", "ab", CondRegex, `^\d+$`, false},
+		{"regex invalid", "ab", CondRegex, `(`, false},
+		{"lengthGreater string", "äöü", CondLengthGreater, 2, true},
+		{"lengthGreater list", []int32{1}, CondLengthGreater, 1, false},
+	}
+
+	for _, tt := range tests {
+		if got := CompareValues(tt.a, tt.op, tt.b); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestEvaluateConditions(t *testing.T) {
+	values := map[string]interface{}{"kind": int32(2), "name": "truck"}
+	conditions := []Condition{
+		NewCondition("kind", CondEquals, 2),
+		NewConditionNotEmpty("name"),
+	}
+	if !EvaluateConditions(conditions, values) {
+		t.Error("expected conditions to hold")
+	}
+	if EvaluateConditions(append(conditions, NewCondition("kind", CondIn, []int{1, 3})), values) {
+		t.Error("expected in condition to fail")
+	}
+	if !EvaluateConditions(nil, values) {
+		t.Error("expected empty conditions to hold")
+	}
+}
+
+func TestConditionExpr(t *testing.T) {
+	// (type == 2 OR type == 3) AND NOT archived
+	expr := And(
+		Or(
+			NewCondition("type", CondEquals, 2),
+			NewCondition("type", CondEquals, 3),
+		),
+		Not(NewCondition("archived", CondEquals, true)),
+	)
+
+	tests := []struct {
+		values map[string]interface{}
+		want   bool
+	}{
+		{map[string]interface{}{"type": int32(3), "archived": false}, true},
+		{map[string]interface{}{"type": int32(3), "archived": true}, false},
+		{map[string]interface{}{"type": int32(1), "archived": false}, false},
+		{map[string]interface{}{"type": int32(2)}, true},
+	}
+	for i, tt := range tests {
+		if got := expr.Evaluate(tt.values); got != tt.want {
+			t.Errorf("case %d: expected %v, got %v", i, tt.want, got)
+		}
+	}
+
+	if fields := expr.Fields(); len(fields) != 2 || fields[0] != "type" || fields[1] != "archived" {
+		t.Errorf("unexpected fields: %v", fields)
+	}
+}
+
+func TestConditionExpr_JSON(t *testing.T) {
+	expr := And(
+		Or(NewCondition("type", CondEquals, 2), NewConditionBetween("count", 1, 10)),
+		Not(NewConditionNotEmpty("archived")),
+	)
+
+	data, err := json.Marshal(expr)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"and":[{"or":[{"field":"type","operator":"equals","value":2},{"field":"count","operator":"between","value":[1,10]}]},{"not":{"field":"archived","operator":"notEmpty"}}]}`
+	if string(data) != want {
+		t.Errorf("unexpected JSON:\n got %s\nwant %s", data, want)
+	}
+
+	parsed, err := ParseConditionExpr(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	roundTrip, _ := json.Marshal(parsed)
+	if string(roundTrip) != want {
+		t.Errorf("round trip changed the expression: %s", roundTrip)
+	}
+	if !parsed.Evaluate(map[string]interface{}{"count": int32(5)}) {
+		t.Error("expected parsed expression to hold")
+	}
+
+	// Flat showWhen array is an AND group
+	flat, err := ParseConditionExpr([]byte(`[{"field":"a","operator":"notEmpty"},{"field":"b","operator":"equals","value":1}]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := flat.(AndCondition); !ok || len(flat.(AndCondition)) != 2 {
+		t.Errorf("expected AND group of 2, got %#v", flat)
+	}
+
+	if _, err := ParseConditionExpr([]byte(`{"value": 1}`)); err == nil {
+		t.Error("expected error for condition without field")
+	}
+}
@@ -0,0 +1,38 @@
+package field
+
+import (
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// ============================================================================
+// Dependent (Cascading) Options
+// ============================================================================
+//
+// A SelectField or ModelField can depend on another field ("vehicle group ->
+// vehicle", "country -> region"): its options are loaded for the current value
+// of the parent field. The frontend reloads the options through the field's
+// DependsOnURL when the parent changes (see echoadapter.DependentOptions); on bind
+// the FormGroup loads the options for the submitted parent value, so the child
+// value is checked against them (see FormGroup.LoadDependentOptions).
+
+// SelectOptionsLoaderFunc loads the options of a dependent SelectField for the
+// parent value (nil = the parent has no value).
+type SelectOptionsLoaderFunc func(ctx *uicontext.UiContext, parent interface{}) ([]SelectOption, error)
+
+// DependentModelLoaderFunc loads the options of a dependent ModelField for the
+// parent value (nil = the parent has no value).
+type DependentModelLoaderFunc func(ctx *uicontext.UiContext, modelType string, parent interface{}) ([]ModelOption, error)
+
+// DependentOptionsField is a field whose options depend on the value of another field.
+type DependentOptionsField interface {
+	FormField
+
+	// GetDependsOn returns the ID of the parent field ("" = not dependent).
+	GetDependsOn() string
+
+	// LoadDependentOptions loads the options for the parent value.
+	LoadDependentOptions(ctx *uicontext.UiContext, parent interface{}) error
+
+	// ExportOptions returns the current options as list of {id, name}.
+	ExportOptions() []map[string]interface{}
+}
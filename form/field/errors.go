@@ -0,0 +1,69 @@
+package field
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrorCode identifies the kind of validation failure of a field.
+// Codes are stable identifiers the frontend can rely on (e.g. to pick a message).
+type ErrorCode string
+
+const (
+	ErrCodeRequired      ErrorCode = "required"      // Value is missing
+	ErrCodeInvalid       ErrorCode = "invalid"       // Value could not be parsed
+	ErrCodeInvalidType   ErrorCode = "invalidType"   // Value has the wrong type
+	ErrCodeMinLength     ErrorCode = "minLength"     // Text too short (params: min)
+	ErrCodeMaxLength     ErrorCode = "maxLength"     // Text too long (params: max)
+	ErrCodePattern       ErrorCode = "pattern"       // Text does not match the pattern (params: pattern)
+	ErrCodeRange         ErrorCode = "range"         // Number or date out of range (params: min and/or max)
+	ErrCodeInvalidOption ErrorCode = "invalidOption" // Value is not one of the options
+	ErrCodeMinItems      ErrorCode = "minItems"      // Too few items selected (params: min)
+	ErrCodeMaxItems      ErrorCode = "maxItems"      // Too many items selected (params: max)
+)
+
+// FieldError is a validation error of a single field.
+// Error() returns the English message, so FieldError can be used wherever
+// a plain error was returned before; group.ValidationErrors collects them.
+type FieldError struct {
+	Field   string                 // Field ID
+	Code    ErrorCode              // Machine-readable error code
+	Params  map[string]interface{} // Parameters for the message (e.g. "min", "max")
+	Message string                 // English fallback message
+}
+
+// NewFieldError creates a field error with a formatted English message.
+//
+// Example:
+//
+//	return NewFieldError(f.ID, ErrCodeMinLength, map[string]interface{}{"min": f.MinLength},
+//	    "text field %s must be at least %d characters", f.ID, f.MinLength)
+func NewFieldError(fieldID string, code ErrorCode, params map[string]interface{}, format string, args ...interface{}) *FieldError {
+	return &FieldError{
+		Field:   fieldID,
+		Code:    code,
+		Params:  params,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// Error returns the English message.
+func (e *FieldError) Error() string {
+	return e.Message
+}
+
+// TranslationKey returns the translation key for the error code,
+// e.g. "FORM_ERROR_REQUIRED" or "FORM_ERROR_MINLENGTH".
+func (e *FieldError) TranslationKey() string {
+	return "FORM_ERROR_" + strings.ToUpper(string(e.Code))
+}
+
+// requiredError returns the standard "required" error for a field.
+func requiredError(kind, fieldID string) *FieldError {
+	return NewFieldError(fieldID, ErrCodeRequired, nil, "%s field %s is required", kind, fieldID)
+}
+
+// invalidTypeError returns the standard "invalid value type" error for a field.
+func invalidTypeError(kind, fieldID string) *FieldError {
+	return NewFieldError(fieldID, ErrCodeInvalidType, nil, "invalid %s value type for %s", kind, fieldID)
+}
@@ -20,26 +20,28 @@ type ArrayField struct {
 func (f *ArrayField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required && !f.AllowEmpty {
-			return fmt.Errorf("array field %s is required", f.ID)
+			return requiredError("array", f.ID)
 		}
 		return nil
 	}
 
 	arr, ok := value.([]interface{})
 	if !ok {
-		return fmt.Errorf("invalid array value type for %s", f.ID)
+		return invalidTypeError("array", f.ID)
 	}
 
 	if !f.AllowEmpty && len(arr) == 0 {
-		return fmt.Errorf("array %s cannot be empty", f.ID)
+		return NewFieldError(f.ID, ErrCodeRequired, nil, "array %s cannot be empty", f.ID)
 	}
 
 	if f.MinItems != nil && len(arr) < *f.MinItems {
-		return fmt.Errorf("array %s must have at least %d items", f.ID, *f.MinItems)
+		return NewFieldError(f.ID, ErrCodeMinItems, map[string]interface{}{"min": *f.MinItems},
+			"array %s must have at least %d items", f.ID, *f.MinItems)
 	}
 
 	if f.MaxItems != nil && len(arr) > *f.MaxItems {
-		return fmt.Errorf("array %s must have at most %d items", f.ID, *f.MaxItems)
+		return NewFieldError(f.ID, ErrCodeMaxItems, map[string]interface{}{"max": *f.MaxItems},
+			"array %s must have at most %d items", f.ID, *f.MaxItems)
 	}
 
 	return nil
@@ -15,14 +15,14 @@ type BoolField struct {
 func (f *BoolField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("bool field %s is required", f.ID)
+			return requiredError("bool", f.ID)
 		}
 		return nil
 	}
 
 	_, ok := value.(bool)
 	if !ok {
-		return fmt.Errorf("invalid bool value type for %s", f.ID)
+		return invalidTypeError("bool", f.ID)
 	}
 
 	return nil
@@ -18,18 +18,18 @@ type ChipsField struct {
 func (f *ChipsField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("chips field %s is required", f.ID)
+			return requiredError("chips", f.ID)
 		}
 		return nil
 	}
 
 	arr, ok := value.([]string)
 	if !ok {
-		return fmt.Errorf("invalid chips value type for %s", f.ID)
+		return invalidTypeError("chips", f.ID)
 	}
 
 	if f.Required && len(arr) == 0 {
-		return fmt.Errorf("chips field %s is required", f.ID)
+		return requiredError("chips", f.ID)
 	}
 
 	return nil
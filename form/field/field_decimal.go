@@ -0,0 +1,351 @@
+package field
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/xiriframework/xiri-go/formatter"
+	"github.com/xiriframework/xiri-go/types/locale"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// Error codes for decimal and money fields
+const (
+	ErrCodeScale     ErrorCode = "scale"     // Too many digits after the decimal separator (params: scale)
+	ErrCodePrecision ErrorCode = "precision" // Too many digits in total (params: precision, scale)
+	ErrCodeStep      ErrorCode = "step"      // Not a multiple of the increment (params: step)
+)
+
+// DecimalField represents a decimal number field with precision/scale.
+//
+// Strings are parsed in Locale if set explicitly, otherwise in the user's locale
+// (UiContext.Locale, set by BindContext):
+// "1.234,56" for comma-decimal locales, "1,234.56" otherwise (see
+// formatter.NormalizeNumberLocale). JSON numbers are taken as is.
+// The value is exported formatted in the same locale, so it round-trips.
+type DecimalField struct {
+	*BaseField
+	Precision  int            // Total number of digits (0 = unlimited)
+	Scale      int            // Digits after the decimal separator
+	Min        *float64       // Minimum value
+	Max        *float64       // Maximum value
+	Increment  float64        // Step between valid values, counted from Min or 0 (0 = any); BaseField.Step is the form step
+	TextPrefix string         // Prefix text (e.g., "$")
+	TextSuffix string         // Suffix text (e.g., "kg")
+	Locale     *locale.Locale // Explicit input/output locale (nil = user's locale from BindContext, else "1234.56")
+	Value      *float64       // Parsed and validated value (type-safe access)
+	ctxLocale  *locale.Locale // User's locale, set by BindContext
+}
+
+// BindContext sets the user's locale, used unless Locale is set explicitly.
+func (f *DecimalField) BindContext(ctx *uicontext.UiContext) {
+	f.ctxLocale = contextLocale(ctx)
+}
+
+func (f *DecimalField) Validate(value interface{}) error {
+	if value == nil {
+		if f.Required {
+			return requiredError("decimal", f.ID)
+		}
+		return nil
+	}
+
+	num, ok := toFloat(value)
+	if !ok {
+		return invalidTypeError("decimal", f.ID)
+	}
+
+	if !hasScale(num, f.Scale) {
+		return NewFieldError(f.ID, ErrCodeScale, map[string]interface{}{"scale": f.Scale},
+			"decimal field %s allows at most %d decimal places", f.ID, f.Scale)
+	}
+
+	if f.Precision > 0 && math.Abs(num) >= math.Pow10(f.Precision-f.Scale) {
+		return NewFieldError(f.ID, ErrCodePrecision, map[string]interface{}{"precision": f.Precision, "scale": f.Scale},
+			"decimal field %s allows at most %d digits", f.ID, f.Precision)
+	}
+
+	if f.Min != nil && num < *f.Min {
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"min": *f.Min}, "decimal field %s must be >= %v", f.ID, *f.Min)
+	}
+
+	if f.Max != nil && num > *f.Max {
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"max": *f.Max}, "decimal field %s must be <= %v", f.ID, *f.Max)
+	}
+
+	if f.Increment > 0 {
+		base := 0.0
+		if f.Min != nil {
+			base = *f.Min
+		}
+		steps := (num - base) / f.Increment
+		if math.Abs(steps-math.Round(steps)) > 1e-9 {
+			return NewFieldError(f.ID, ErrCodeStep, map[string]interface{}{"step": f.Increment},
+				"decimal field %s must be a multiple of %v", f.ID, f.Increment)
+		}
+	}
+
+	return nil
+}
+
+// Parse converts the raw value into a float64. Strings are parsed in Locale.
+func (f *DecimalField) Parse(raw interface{}) (interface{}, error) {
+	if raw == nil {
+		return f.GetDefault(), nil
+	}
+
+	switch v := raw.(type) {
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil, nil
+		}
+		normalized, err := normalizeNumber(v, fieldLocale(f.Locale, f.ctxLocale))
+		if err != nil {
+			return nil, err
+		}
+		return strconv.ParseFloat(normalized, 64)
+	default:
+		if num, ok := toFloat(v); ok {
+			return num, nil
+		}
+		return nil, fmt.Errorf("cannot parse decimal from %T", raw)
+	}
+}
+
+// BindValue parses, validates, and stores the value in the field
+func (f *DecimalField) BindValue(raw interface{}) error {
+	parsed, err := f.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("parsing field %s: %w", f.ID, err)
+	}
+
+	if err := f.Validate(parsed); err != nil {
+		return fmt.Errorf("validating field %s: %w", f.ID, err)
+	}
+
+	if parsed != nil {
+		if num, ok := toFloat(parsed); ok {
+			f.Value = &num
+		}
+	} else {
+		f.Value = nil
+	}
+
+	return nil
+}
+
+// ============================================================================
+// Builder Functions
+// ============================================================================
+
+// NewDecimalField creates a decimal form field with precision (total digits, 0 = unlimited)
+// and scale (digits after the decimal separator)
+//
+// Example:
+//
+//	weight := field.NewDecimalField("weight", "WEIGHT", true, 0, 8, 3).SetMin(0).SetIncrement(0.5)
+func NewDecimalField(id, name string, required bool, currentValue float64, precision, scale int) *DecimalField {
+	return &DecimalField{
+		BaseField: &BaseField{
+			ID:       id,
+			Type:     FieldTypeDecimal,
+			Name:     name,
+			Required: required,
+			Default:  currentValue,
+			Form:     true,
+		},
+		Precision: precision,
+		Scale:     scale,
+	}
+}
+
+// ExportForFrontend exports the field for frontend rendering
+func (f *DecimalField) ExportForFrontend(ctx *uicontext.UiContext, value interface{}) map[string]interface{} {
+	if value == nil {
+		value = f.GetDefault()
+	}
+	result := f.BaseField.GetBaseExport(ctx, value)
+
+	loc := exportLocale(ctx, f.Locale, f.ctxLocale)
+	if num, ok := toFloat(value); ok {
+		result["value"] = formatNumber(num, f.Scale, loc)
+		result["raw"] = num
+	}
+
+	result["precision"] = f.Precision
+	result["scale"] = f.Scale
+	if f.Min != nil {
+		result["min"] = *f.Min
+	}
+	if f.Max != nil {
+		result["max"] = *f.Max
+	}
+	if f.Increment > 0 {
+		result["increment"] = f.Increment
+	}
+	if f.TextPrefix != "" {
+		result["textPrefix"] = f.TextPrefix
+	}
+	if f.TextSuffix != "" {
+		result["textSuffix"] = f.TextSuffix
+	}
+	addSeparatorExport(result, loc)
+
+	return result
+}
+
+// ============================================================================
+// Locale Helpers (shared with MoneyField)
+// ============================================================================
+
+// normalizeNumber converts a number string in loc into canonical form ("-1234.56").
+func normalizeNumber(s string, loc *locale.Locale) (string, error) {
+	if loc == nil {
+		s = strings.TrimSpace(s)
+		if _, err := strconv.ParseFloat(s, 64); err != nil {
+			return "", fmt.Errorf("invalid number: %q", s)
+		}
+		return s, nil
+	}
+	return formatter.NormalizeNumberLocale(s, *loc)
+}
+
+// formatNumber formats a number with the given decimals in loc (nil = "1234.56").
+func formatNumber(num float64, decimals int, loc *locale.Locale) string {
+	if loc == nil {
+		return strconv.FormatFloat(num, 'f', decimals, 64)
+	}
+	return formatter.FormatNumberLocale(num, decimals, *loc)
+}
+
+// contextLocale returns the locale of the context (nil without context).
+func contextLocale(ctx *uicontext.UiContext) *locale.Locale {
+	if ctx == nil {
+		return nil
+	}
+	loc := ctx.Locale
+	return &loc
+}
+
+// fieldLocale returns the locale used for parsing: the explicit field locale,
+// falling back to the locale bound by BindContext.
+func fieldLocale(explicit, bound *locale.Locale) *locale.Locale {
+	if explicit != nil {
+		return explicit
+	}
+	return bound
+}
+
+// exportLocale returns the locale used for export, in the same precedence as
+// parsing: the explicit field locale, then the export context, then the locale
+// bound by BindContext. An exported value is therefore accepted on submit.
+func exportLocale(ctx *uicontext.UiContext, explicit, bound *locale.Locale) *locale.Locale {
+	if explicit != nil {
+		return explicit
+	}
+	if loc := contextLocale(ctx); loc != nil {
+		return loc
+	}
+	return bound
+}
+
+// addSeparatorExport adds the locale and its separators to a field export.
+func addSeparatorExport(result map[string]interface{}, loc *locale.Locale) {
+	if loc == nil {
+		result["decimalSeparator"] = "."
+		result["thousandsSeparator"] = ""
+		return
+	}
+	result["locale"] = loc.GetLocaleString()
+	if formatter.UsesCommaDecimal(*loc) {
+		result["decimalSeparator"] = ","
+		result["thousandsSeparator"] = "."
+	} else {
+		result["decimalSeparator"] = "."
+		result["thousandsSeparator"] = ","
+	}
+}
+
+// hasScale returns true if num has at most scale decimal places.
+func hasScale(num float64, scale int) bool {
+	shifted := num * math.Pow10(scale)
+	return math.Abs(shifted-math.Round(shifted)) <= 1e-9*math.Max(1, math.Abs(shifted))
+}
+
+// ============================================================================
+// Chainable Setter Methods
+// ============================================================================
+
+// SetMin sets the minimum value
+func (f *DecimalField) SetMin(min float64) *DecimalField {
+	f.Min = &min
+	return f
+}
+
+// SetMax sets the maximum value
+func (f *DecimalField) SetMax(max float64) *DecimalField {
+	f.Max = &max
+	return f
+}
+
+// SetIncrement sets the step between valid values (0 = any)
+func (f *DecimalField) SetIncrement(increment float64) *DecimalField {
+	f.Increment = increment
+	return f
+}
+
+// SetLocale sets the input/output locale
+func (f *DecimalField) SetLocale(loc locale.Locale) *DecimalField {
+	f.Locale = &loc
+	return f
+}
+
+// SetTextSuffix sets the suffix text (e.g., "kg")
+func (f *DecimalField) SetTextSuffix(suffix string) *DecimalField {
+	f.TextSuffix = suffix
+	return f
+}
+
+// SetClass sets the CSS class for frontend styling
+func (f *DecimalField) SetClass(class string) *DecimalField {
+	f.BaseField.SetClass(class)
+	return f
+}
+
+// SetHint sets the tooltip/help text for the field
+func (f *DecimalField) SetHint(hint string) *DecimalField {
+	f.BaseField.SetHint(hint)
+	return f
+}
+
+// SetStep sets the step indicator for multi-step forms
+func (f *DecimalField) SetStep(step int) *DecimalField {
+	f.BaseField.SetStep(step)
+	return f
+}
+
+// SetDisabled sets whether the field is disabled
+func (f *DecimalField) SetDisabled(disabled bool) *DecimalField {
+	f.BaseField.SetDisabled(disabled)
+	return f
+}
+
+// SetAccess sets the access control permissions
+func (f *DecimalField) SetAccess(access []string) *DecimalField {
+	f.BaseField.SetAccess(access)
+	return f
+}
+
+// SetScenario sets which scenarios this field applies to
+func (f *DecimalField) SetScenario(scenario []string) *DecimalField {
+	f.BaseField.SetScenario(scenario)
+	return f
+}
+
+// SetForm sets whether to show in form
+func (f *DecimalField) SetForm(form bool) *DecimalField {
+	f.BaseField.SetForm(form)
+	return f
+}
@@ -0,0 +1,154 @@
+package field
+
+import (
+	"testing"
+
+	"github.com/xiriframework/xiri-go/types/locale"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+func TestDecimalField_ParseLocale(t *testing.T) {
+	tests := []struct {
+		loc   locale.Locale
+		input string
+		want  float64
+	}{
+		{locale.De, "1.234,56", 1234.56},
+		{locale.De, "1234,5", 1234.5},
+		{locale.De, "-0,25", -0.25},
+		{locale.EnUS, "1,234.56", 1234.56},
+		{locale.EnGB, "1234.56", 1234.56},
+	}
+	for _, tt := range tests {
+		f := NewDecimalField("weight", "WEIGHT", true, 0, 10, 2)
+		f.BindContext(&uicontext.UiContext{Locale: tt.loc})
+		got, err := f.Parse(tt.input)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: expected %v, got %v", tt.input, tt.want, got)
+		}
+	}
+
+	de := NewDecimalField("weight", "WEIGHT", true, 0, 10, 2).SetLocale(locale.De)
+	for _, input := range []string{"1234.56", "1.23,4", "12,", "abc"} {
+		if _, err := de.Parse(input); err == nil {
+			t.Errorf("%q: expected parse error", input)
+		}
+	}
+}
+
+func TestDecimalField_Validate(t *testing.T) {
+	f := NewDecimalField("weight", "WEIGHT", true, 0, 5, 2).SetMin(0).SetIncrement(0.25)
+
+	tests := []struct {
+		value interface{}
+		code  ErrorCode
+	}{
+		{12.5, ""},
+		{nil, ErrCodeRequired},
+		{1.234, ErrCodeScale},
+		{1000.0, ErrCodePrecision},
+		{-1.0, ErrCodeRange},
+		{0.3, ErrCodeStep},
+		{"x", ErrCodeInvalidType},
+	}
+	for _, tt := range tests {
+		err := f.Validate(tt.value)
+		if tt.code == "" {
+			if err != nil {
+				t.Errorf("%v: unexpected error: %v", tt.value, err)
+			}
+			continue
+		}
+		if fe, ok := err.(*FieldError); !ok || fe.Code != tt.code {
+			t.Errorf("%v: expected %s, got %v", tt.value, tt.code, err)
+		}
+	}
+}
+
+func TestDecimalField_ExportRoundTrip(t *testing.T) {
+	ctx := &uicontext.UiContext{Locale: locale.De}
+	f := NewDecimalField("weight", "WEIGHT", true, 0, 10, 2)
+	f.BindContext(ctx)
+
+	exported := f.ExportForFrontend(ctx, 1234.5)
+	if exported["value"] != "1.234,50" || exported["decimalSeparator"] != "," {
+		t.Fatalf("unexpected export: %v", exported)
+	}
+	if err := f.BindValue(exported["value"]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.Value == nil || *f.Value != 1234.5 {
+		t.Errorf("round trip changed the value: %v", f.Value)
+	}
+}
+
+func TestDecimalField_ExplicitLocale(t *testing.T) {
+	de := &uicontext.UiContext{Locale: locale.De}
+	f := NewDecimalField("weight", "WEIGHT", true, 0, 10, 2).SetLocale(locale.EnUS)
+	f.BindContext(de)
+
+	// The explicit locale wins over the user's locale on export and parse
+	exported := f.ExportForFrontend(de, 1234.56)
+	if exported["value"] != "1,234.56" || exported["decimalSeparator"] != "." {
+		t.Fatalf("unexpected export: %v", exported)
+	}
+	if err := f.BindValue(exported["value"]); err != nil || *f.Value != 1234.56 {
+		t.Errorf("exported value rejected: %v (%v)", f.Value, err)
+	}
+
+	// Without explicit locale, a new context replaces the bound one
+	g := NewDecimalField("weight", "WEIGHT", true, 0, 10, 2)
+	g.BindContext(de)
+	g.BindContext(&uicontext.UiContext{Locale: locale.EnUS})
+	if got, err := g.Parse("1,234.56"); err != nil || got != 1234.56 {
+		t.Errorf("expected user locale of the latest context, got %v (%v)", got, err)
+	}
+}
+
+func TestMoneyField(t *testing.T) {
+	ctx := &uicontext.UiContext{Locale: locale.De}
+	f := NewMoneyField("price", "PRICE", true, 1999, "eur").SetMin(0)
+	f.BindContext(ctx)
+
+	if f.Currency != "EUR" || f.MinorUnits != 2 {
+		t.Fatalf("unexpected currency setup: %s/%d", f.Currency, f.MinorUnits)
+	}
+
+	tests := []struct {
+		raw  interface{}
+		want int64
+	}{
+		{"1.234,56", 123456},
+		{"0,1", 10},
+		{float64(19.99), 1999},
+		{int64(500), 500},
+		{nil, 1999},
+	}
+	for _, tt := range tests {
+		got, err := f.Parse(tt.raw)
+		if err != nil || got != tt.want {
+			t.Errorf("%v: expected %d, got %v (%v)", tt.raw, tt.want, got, err)
+		}
+	}
+
+	if _, err := f.Parse("1,234"); err == nil {
+		t.Error("expected scale error for three decimals")
+	}
+	if err := f.BindValue("-1,00"); err == nil {
+		t.Error("expected range error for negative amount")
+	}
+
+	exported := f.ExportForFrontend(ctx, int64(123456))
+	if exported["value"] != "1.234,56" || exported["currency"] != "EUR" || exported["raw"] != int64(123456) {
+		t.Errorf("unexpected export: %v", exported)
+	}
+
+	yen := NewMoneyField("price", "PRICE", true, 0, "JPY").SetLocale(locale.Ja)
+	if got, err := yen.Parse("1,500"); err != nil || got != int64(1500) {
+		t.Errorf("expected 1500 yen, got %v (%v)", got, err)
+	}
+}
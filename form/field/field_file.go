@@ -1,44 +1,315 @@
 package field
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path"
+	"strings"
+
 	"github.com/xiriframework/xiri-go/uicontext"
 )
 
-import "fmt"
+// Error codes for file uploads
+const (
+	ErrCodeFileSize      ErrorCode = "fileSize"      // File larger than MaxSize (params: max)
+	ErrCodeFileType      ErrorCode = "fileType"      // Detected MIME type not allowed (params: type, allowed)
+	ErrCodeFileExtension ErrorCode = "fileExtension" // File extension not allowed (params: extension, allowed)
+	ErrCodeUpload        ErrorCode = "upload"        // File could not be read or stored
+)
+
+// sniffLen is the number of bytes used to detect the MIME type (see http.DetectContentType).
+const sniffLen = 512
 
-// FileField represents a file upload form field
+// FileField represents a file upload form field.
+//
+// BindAndValidate streams multipart/form-data uploads through Receive: MaxSize is
+// enforced while reading, the MIME type is detected from the content (the client's
+// Content-Type header is ignored) and the extension is checked. Accepted files are
+// handed to the FileStore; Value holds their metadata.
 type FileField struct {
 	*BaseField
-	MaxSize           int64    // Maximum file size in bytes
-	AllowedTypes      []string // Allowed MIME types (e.g., ["image/jpeg", "image/png"])
-	AllowedExtensions []string // Allowed file extensions (e.g., [".jpg", ".png"])
-	Multiple          bool     // If true, multiple files can be uploaded
+	MaxSize           int64     // Maximum file size in bytes (0 = unlimited)
+	AllowedTypes      []string  // Allowed MIME types (e.g., ["image/jpeg", "image/png"] or ["image/*"])
+	AllowedExtensions []string  // Allowed file extensions (e.g., [".jpg", ".png"])
+	Multiple          bool      // If true, multiple files can be uploaded
+	Store             FileStore // Storage for received files (required for uploads)
+	Value             FileValue // Received files (type-safe access)
 }
 
-// Validate checks whether the file field value meets constraints.
-// File content validation (size, MIME type) happens at upload time via the HTTP handler,
-// not here. This method only enforces the Required constraint.
+// Validate checks the required constraint, the number of files and the metadata
+// of each file against MaxSize, AllowedTypes and AllowedExtensions.
 func (f *FileField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("file field %s is required", f.ID)
+			return requiredError("file", f.ID)
+		}
+		return nil
+	}
+
+	files, ok := value.(FileValue)
+	if !ok {
+		if f.Store != nil {
+			// Uploads must be received files, not client-supplied references
+			return invalidTypeError("file", f.ID)
+		}
+		// Legacy values (e.g. a file name reference) are not checked
+		return nil
+	}
+	if len(files) == 0 {
+		if f.Required {
+			return requiredError("file", f.ID)
 		}
 		return nil
 	}
+	if !f.Multiple && len(files) > 1 {
+		return NewFieldError(f.ID, ErrCodeMaxItems, map[string]interface{}{"max": 1},
+			"file field %s accepts only one file", f.ID)
+	}
+	for _, file := range files {
+		if err := f.checkFile(file); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-// Parse returns the raw value as-is.
-// File fields are handled differently from other form fields: the actual file data
-// is processed by the HTTP multipart handler, not by the form field parser.
-// The value here is typically a filename or file metadata reference.
+// Parse converts the raw value into a FileValue.
+// Files received by BindAndValidate arrive as FileValue; an upload error recorded
+// for the field is returned as is. With a Store configured, any other value (e.g. a
+// string in a JSON body) is rejected with ErrCodeInvalidType; without a Store it is
+// returned unchanged (legacy file name reference).
 func (f *FileField) Parse(raw interface{}) (interface{}, error) {
-	if raw == nil {
+	switch v := raw.(type) {
+	case nil:
 		return f.GetDefault(), nil
+	case error:
+		return nil, v
+	case FileValue:
+		return v, nil
+	case UploadedFile:
+		return FileValue{v}, nil
+	case []UploadedFile:
+		return FileValue(v), nil
+	}
+	if f.Store != nil {
+		return nil, invalidTypeError("file", f.ID)
 	}
 	return raw, nil
 }
 
+// BindValue parses, validates, and stores the value in the field.
+// Besides the values accepted by Parse, multipart file headers
+// (*multipart.FileHeader or []*multipart.FileHeader) are received via Receive.
+func (f *FileField) BindValue(raw interface{}) error {
+	if header, ok := raw.(*multipart.FileHeader); ok {
+		raw = []*multipart.FileHeader{header}
+	}
+	if headers, ok := raw.([]*multipart.FileHeader); ok {
+		files, err := f.receiveHeaders(headers)
+		if err != nil {
+			return err
+		}
+		raw = files
+	}
+
+	parsed, err := f.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("parsing field %s: %w", f.ID, err)
+	}
+
+	if err := f.Validate(parsed); err != nil {
+		f.Discard(parsed)
+		return fmt.Errorf("validating field %s: %w", f.ID, err)
+	}
+
+	if files, ok := parsed.(FileValue); ok {
+		f.Value = files
+	} else {
+		f.Value = nil
+	}
+
+	return nil
+}
+
+// Receive checks and stores a single uploaded file, reading r only once.
+// The size is enforced while streaming to the store, the MIME type is detected
+// from the first bytes of the content and the extension is taken from name.
+//
+// Example:
+//
+//	file, err := logo.Receive(header.Filename, part)
+func (f *FileField) Receive(name string, r io.Reader) (UploadedFile, error) {
+	file := UploadedFile{Name: cleanFileName(name)}
+	if f.Store == nil {
+		return file, NewFieldError(f.ID, ErrCodeUpload, nil, "file field %s has no file store", f.ID)
+	}
+	if err := f.checkExtension(file); err != nil {
+		return file, err
+	}
+
+	// Detect the MIME type from the content
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(r, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return file, NewFieldError(f.ID, ErrCodeUpload, nil, "reading file for %s: %v", f.ID, err)
+	}
+	head = head[:n]
+	file.ContentType = detectContentType(head)
+	if err := f.checkType(file); err != nil {
+		return file, err
+	}
+
+	// Stream to the store, enforcing MaxSize
+	counter := &sizeLimitReader{r: io.MultiReader(bytes.NewReader(head), r), max: f.MaxSize}
+	key, err := f.Store.Save(file, counter)
+	if err != nil {
+		if errors.Is(err, errFileTooLarge) {
+			return file, f.sizeError()
+		}
+		return file, NewFieldError(f.ID, ErrCodeUpload, nil, "storing file for %s: %v", f.ID, err)
+	}
+	file.Key = key
+	file.Size = counter.n
+	return file, nil
+}
+
+// Discard deletes the stored files of value (a FileValue) from the store,
+// e.g. when the rest of the form failed validation.
+func (f *FileField) Discard(value interface{}) {
+	files, ok := value.(FileValue)
+	if !ok || f.Store == nil {
+		return
+	}
+	for _, file := range files {
+		if file.Key != "" {
+			_ = f.Store.Delete(file.Key)
+		}
+	}
+}
+
+// receiveHeaders receives multipart file headers (from ParseMultipartForm).
+func (f *FileField) receiveHeaders(headers []*multipart.FileHeader) (FileValue, error) {
+	files := make(FileValue, 0, len(headers))
+	for _, h := range headers {
+		if f.MaxSize > 0 && h.Size > f.MaxSize {
+			f.Discard(files)
+			return nil, f.sizeError()
+		}
+		src, err := h.Open()
+		if err != nil {
+			f.Discard(files)
+			return nil, NewFieldError(f.ID, ErrCodeUpload, nil, "opening file for %s: %v", f.ID, err)
+		}
+		file, err := f.Receive(h.Filename, src)
+		src.Close()
+		if err != nil {
+			f.Discard(files)
+			return nil, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// checkFile checks the metadata of a received file.
+func (f *FileField) checkFile(file UploadedFile) error {
+	if f.MaxSize > 0 && file.Size > f.MaxSize {
+		return f.sizeError()
+	}
+	if err := f.checkExtension(file); err != nil {
+		return err
+	}
+	return f.checkType(file)
+}
+
+// checkExtension checks the file extension against AllowedExtensions (case-insensitive).
+func (f *FileField) checkExtension(file UploadedFile) error {
+	if len(f.AllowedExtensions) == 0 {
+		return nil
+	}
+	ext := file.Extension()
+	for _, allowed := range f.AllowedExtensions {
+		allowed = strings.ToLower(allowed)
+		if !strings.HasPrefix(allowed, ".") {
+			allowed = "." + allowed
+		}
+		if ext == allowed {
+			return nil
+		}
+	}
+	return NewFieldError(f.ID, ErrCodeFileExtension,
+		map[string]interface{}{"extension": ext, "allowed": f.AllowedExtensions},
+		"file extension %q is not allowed for %s", ext, f.ID)
+}
+
+// checkType checks the detected MIME type against AllowedTypes ("image/*" allows all images).
+func (f *FileField) checkType(file UploadedFile) error {
+	if len(f.AllowedTypes) == 0 {
+		return nil
+	}
+	for _, allowed := range f.AllowedTypes {
+		allowed = strings.ToLower(allowed)
+		if allowed == file.ContentType {
+			return nil
+		}
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(file.ContentType, prefix+"/") {
+			return nil
+		}
+	}
+	return NewFieldError(f.ID, ErrCodeFileType,
+		map[string]interface{}{"type": file.ContentType, "allowed": f.AllowedTypes},
+		"file type %s is not allowed for %s", file.ContentType, f.ID)
+}
+
+// sizeError returns the error for a file larger than MaxSize.
+func (f *FileField) sizeError() *FieldError {
+	return NewFieldError(f.ID, ErrCodeFileSize, map[string]interface{}{"max": f.MaxSize},
+		"file for %s exceeds the maximum size of %d bytes", f.ID, f.MaxSize)
+}
+
+// errFileTooLarge is returned by sizeLimitReader once more than max bytes were read.
+var errFileTooLarge = errors.New("file too large")
+
+// sizeLimitReader counts the bytes read and fails once max is exceeded (max 0 = unlimited).
+type sizeLimitReader struct {
+	r   io.Reader
+	max int64
+	n   int64
+}
+
+func (l *sizeLimitReader) Read(p []byte) (int, error) {
+	n, err := l.r.Read(p)
+	l.n += int64(n)
+	if l.max > 0 && l.n > l.max {
+		return n, errFileTooLarge
+	}
+	return n, err
+}
+
+// detectContentType returns the MIME type of the content without parameters.
+func detectContentType(head []byte) string {
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return "application/octet-stream"
+	}
+	return mediaType
+}
+
+// cleanFileName returns the base name of a client file name (Windows paths included).
+func cleanFileName(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = path.Base(name)
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
+
 // ============================================================================
 // Builder Functions
 // ============================================================================
@@ -135,3 +406,27 @@ func (f *FileField) SetForm(form bool) *FileField {
 	f.BaseField.SetForm(form)
 	return f
 }
+
+// SetAllowedTypes sets the allowed MIME types (e.g. "image/png" or "image/*")
+func (f *FileField) SetAllowedTypes(types ...string) *FileField {
+	f.AllowedTypes = types
+	return f
+}
+
+// SetAllowedExtensions sets the allowed file extensions (e.g. ".pdf")
+func (f *FileField) SetAllowedExtensions(extensions ...string) *FileField {
+	f.AllowedExtensions = extensions
+	return f
+}
+
+// SetMultiple sets whether multiple files can be uploaded
+func (f *FileField) SetMultiple(multiple bool) *FileField {
+	f.Multiple = multiple
+	return f
+}
+
+// SetStore sets the storage for received files
+func (f *FileField) SetStore(store FileStore) *FileField {
+	f.Store = store
+	return f
+}
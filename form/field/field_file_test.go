@@ -0,0 +1,125 @@
+package field
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestFileField_Receive(t *testing.T) {
+	store := NewMemoryFileStore()
+	f := NewFileField("logo", "LOGO", true, 1024).
+		SetAllowedTypes("image/*").
+		SetAllowedExtensions("png", ".JPG").
+		SetStore(store)
+
+	file, err := f.Receive(`C:\Users\me\Logo.PNG`, bytes.NewReader(pngHeader))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if file.Name != "Logo.PNG" || file.ContentType != "image/png" || file.Size != int64(len(pngHeader)) {
+		t.Errorf("unexpected metadata: %+v", file)
+	}
+	if data, ok := store.Get(file.Key); !ok || !bytes.Equal(data, pngHeader) {
+		t.Error("expected content in store")
+	}
+	if !strings.HasSuffix(file.Key, ".png") {
+		t.Errorf("expected key to keep the extension, got %s", file.Key)
+	}
+}
+
+func TestFileField_ReceiveRejects(t *testing.T) {
+	store := NewMemoryFileStore()
+	f := NewFileField("logo", "LOGO", true, 1024).
+		SetAllowedTypes("image/png").
+		SetAllowedExtensions(".png").
+		SetStore(store)
+
+	tests := []struct {
+		name    string
+		file    string
+		content io.Reader
+		code    ErrorCode
+	}{
+		{"text content with png name", "fake.png", strings.NewReader("hello world"), ErrCodeFileType},
+		{"wrong extension", "logo.gif", bytes.NewReader(pngHeader), ErrCodeFileExtension},
+		{"too large", "big.png", io.MultiReader(bytes.NewReader(pngHeader), bytes.NewReader(make([]byte, 2048))), ErrCodeFileSize},
+	}
+	for _, tt := range tests {
+		_, err := f.Receive(tt.file, tt.content)
+		fe, ok := err.(*FieldError)
+		if !ok || fe.Code != tt.code {
+			t.Errorf("%s: expected %s error, got %v", tt.name, tt.code, err)
+		}
+	}
+	if store.Len() != 0 {
+		t.Errorf("expected no stored files, got %d", store.Len())
+	}
+
+	if _, err := NewFileField("doc", "DOC", false, 0).Receive("a.txt", strings.NewReader("x")); err == nil {
+		t.Error("expected error without file store")
+	}
+}
+
+func TestFileField_BindValue(t *testing.T) {
+	f := NewFileField("docs", "DOCS", true, 0)
+
+	if err := f.BindValue(nil); err == nil {
+		t.Error("expected required error")
+	}
+
+	files := FileValue{{Key: "a", Name: "a.pdf"}, {Key: "b", Name: "b.pdf"}}
+	if err := f.BindValue(files); err == nil {
+		t.Error("expected error for multiple files on single file field")
+	}
+
+	f.SetMultiple(true)
+	if err := f.BindValue(files); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(f.Value) != 2 || f.Value.First().Key != "a" {
+		t.Errorf("unexpected value: %v", f.Value)
+	}
+}
+
+func TestFileField_RejectsNonFileValues(t *testing.T) {
+	f := NewFileField("logo", "LOGO", true, 1024).SetStore(NewMemoryFileStore())
+
+	for _, raw := range []interface{}{"x", 42.0, map[string]interface{}{"key": "a"}} {
+		var fieldErr *FieldError
+		if err := f.BindValue(raw); !errors.As(err, &fieldErr) || fieldErr.Code != ErrCodeInvalidType {
+			t.Errorf("expected invalidType error for %v, got %v", raw, err)
+		}
+	}
+	if err := f.Validate("x"); err == nil {
+		t.Error("expected Validate to reject a non-file value")
+	}
+}
+
+func TestLocalFileStore(t *testing.T) {
+	store := NewLocalFileStore(t.TempDir())
+
+	key, err := store.Save(UploadedFile{Name: "report.pdf"}, strings.NewReader("content"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r, err := store.Open(key)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	data, _ := io.ReadAll(r)
+	r.Close()
+	if string(data) != "content" {
+		t.Errorf("unexpected content: %q", data)
+	}
+	if err := store.Delete(key); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := store.Open("../secret"); err == nil {
+		t.Error("expected error for key outside the directory")
+	}
+}
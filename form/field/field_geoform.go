@@ -25,14 +25,14 @@ type GeoformValue struct {
 func (f *GeoformField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("geoform field %s is required", f.ID)
+			return requiredError("geoform", f.ID)
 		}
 		return nil
 	}
 
 	gv, ok := value.(*GeoformValue)
 	if !ok {
-		return fmt.Errorf("invalid geoform value type for %s", f.ID)
+		return invalidTypeError("geoform", f.ID)
 	}
 
 	// Validate based on type
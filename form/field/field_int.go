@@ -24,7 +24,7 @@ type IntField struct {
 func (f *IntField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("int field %s is required", f.ID)
+			return requiredError("int", f.ID)
 		}
 		return nil
 	}
@@ -38,15 +38,15 @@ func (f *IntField) Validate(value interface{}) error {
 	case int64:
 		num = int(v)
 	default:
-		return fmt.Errorf("invalid int value type for %s", f.ID)
+		return invalidTypeError("int", f.ID)
 	}
 
 	if f.Min != nil && num < *f.Min {
-		return fmt.Errorf("int field %s must be >= %d", f.ID, *f.Min)
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"min": *f.Min}, "int field %s must be >= %d", f.ID, *f.Min)
 	}
 
 	if f.Max != nil && num > *f.Max {
-		return fmt.Errorf("int field %s must be <= %d", f.ID, *f.Max)
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"max": *f.Max}, "int field %s must be <= %d", f.ID, *f.Max)
 	}
 
 	return nil
@@ -16,7 +16,7 @@ type JsonField struct {
 func (f *JsonField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("json field %s is required", f.ID)
+			return requiredError("json", f.ID)
 		}
 		return nil
 	}
@@ -26,7 +26,7 @@ func (f *JsonField) Validate(value interface{}) error {
 	case map[string]interface{}, []interface{}, string:
 		return nil
 	default:
-		return fmt.Errorf("invalid json value type for %s", f.ID)
+		return invalidTypeError("json", f.ID)
 	}
 }
 
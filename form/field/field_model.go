@@ -31,15 +31,34 @@ type ModelField struct {
 	AllowSearch bool                   // If true, search/autocomplete is enabled
 	Params      map[string]interface{} // Additional parameters for API call
 	LoaderFunc  ModelLoaderFunc        // Function to load options from database
+	PagedLoader PagedModelLoader       // Remote options loader (see SetRemoteOptions)
 	Options     []ModelOption          // Loaded options (populated by LoadOptions)
 	Value       int32                  // Parsed and validated value (type-safe access - always has value)
+
+	// Dependent options (see SetDependsOn)
+	DependsOn       string                   // ID of the parent field ("" = not dependent)
+	DependsOnURL    string                   // Endpoint returning the options for a parent value
+	DependentLoader DependentModelLoaderFunc // Loads the options for the parent value
+
+	ctx *uicontext.UiContext // Context for resolving submitted IDs (set by BindContext)
 }
 
 // LoadOptions implements FieldOptionsLoader interface
-// Loads model options using the configured LoaderFunc
+// Loads model options using the configured LoaderFunc. With remote options only
+// the selected options (current value) are loaded, so the frontend can show them.
 func (f *ModelField) LoadOptions(ctx *uicontext.UiContext) error {
-	if f.LoaderFunc == nil {
+	if f.PagedLoader != nil {
+		options, err := f.ResolveOptions(ctx, f.selectedIDs(f.GetDefault()))
+		if err != nil {
+			return fmt.Errorf("loading options for model field %s: %w", f.ID, err)
+		}
+		f.Options = options
+		return nil
+	}
+
+	if f.LoaderFunc == nil || f.DependsOn != "" {
 		// No loader function = options are static or loaded externally
+		// (dependent options are loaded for the parent value by LoadDependentOptions)
 		return nil
 	}
 
@@ -57,10 +76,36 @@ func (f *ModelField) SetLoaderFunc(loader ModelLoaderFunc) {
 	f.LoaderFunc = loader
 }
 
+// BindContext keeps the user context for resolving submitted IDs with remote options.
+func (f *ModelField) BindContext(ctx *uicontext.UiContext) {
+	f.ctx = ctx
+}
+
+// HasRemoteOptions returns true if the field uses remote options
+func (f *ModelField) HasRemoteOptions() bool {
+	return f.PagedLoader != nil
+}
+
+// SearchOptions returns one page of remote options for a search term
+func (f *ModelField) SearchOptions(ctx *uicontext.UiContext, search string, page, pageSize int) (ModelOptionsPage, error) {
+	return searchRemoteOptions(f.PagedLoader, ctx, ModelOptionsQuery{
+		ModelType: f.ModelType,
+		Search:    search,
+		Page:      page,
+		PageSize:  pageSize,
+		Filter:    f.Filter,
+	}, f.Sub)
+}
+
+// ResolveOptions returns the remote options with the given IDs
+func (f *ModelField) ResolveOptions(ctx *uicontext.UiContext, ids []int32) ([]ModelOption, error) {
+	return resolveRemoteOptions(f.PagedLoader, ctx, f.ModelType, ids, f.Sub)
+}
+
 func (f *ModelField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("model field %s is required", f.ID)
+			return requiredError("model", f.ID)
 		}
 		return nil
 	}
@@ -68,10 +113,78 @@ func (f *ModelField) Validate(value interface{}) error {
 	// Accept int, int32, int64 for model ID
 	switch value.(type) {
 	case int, int32, int64:
-		return nil
 	default:
-		return fmt.Errorf("invalid model value type for %s, expected int", f.ID)
+		return NewFieldError(f.ID, ErrCodeInvalidType, nil, "invalid model value type for %s, expected int", f.ID)
+	}
+
+	// Remote options: the ID must be known to the loader (0 = no selection)
+	if ids := f.selectedIDs(value); f.PagedLoader != nil && len(ids) > 0 {
+		return checkRemoteOptions(f.PagedLoader, f.ctx, f.ID, f.ModelType, ids, f.Sub)
+	}
+
+	// Dependent options: the ID must be one of the options for the parent value
+	if ids := f.selectedIDs(value); f.DependsOn != "" && len(ids) > 0 {
+		for _, opt := range withoutIDs(f.Options, f.Sub) {
+			if opt.ID == ids[0] {
+				return nil
+			}
+		}
+		return NewFieldError(f.ID, ErrCodeInvalidOption, map[string]interface{}{"value": ids[0]},
+			"invalid option %d for field %s", ids[0], f.ID)
+	}
+	return nil
+}
+
+// GetDependsOn returns the ID of the parent field ("" = not dependent)
+func (f *ModelField) GetDependsOn() string {
+	return f.DependsOn
+}
+
+// LoadDependentOptions loads the options for the parent value using DependentLoader
+func (f *ModelField) LoadDependentOptions(ctx *uicontext.UiContext, parent interface{}) error {
+	if f.DependentLoader == nil {
+		return nil
+	}
+	options, err := f.DependentLoader(ctx, f.ModelType, parent)
+	if err != nil {
+		return fmt.Errorf("loading options for model field %s: %w", f.ID, err)
+	}
+	f.Options = options
+	return nil
+}
+
+// ExportOptions returns the options (Options or List) as list of {id, name}, without Sub IDs
+func (f *ModelField) ExportOptions() []map[string]interface{} {
+	options := f.Options
+	if len(options) == 0 && len(f.List) > 0 {
+		options = f.List
+	}
+
+	exportedOptions := make([]map[string]interface{}, 0, len(options))
+	for _, opt := range withoutIDs(options, f.Sub) {
+		exportedOptions = append(exportedOptions, map[string]interface{}{
+			"id":   opt.ID,
+			"name": opt.Name,
+		})
+	}
+	return exportedOptions
+}
+
+// selectedIDs returns the selected ID of a value as a list (empty for 0 or nil).
+func (f *ModelField) selectedIDs(value interface{}) []int32 {
+	var id int32
+	switch v := value.(type) {
+	case int:
+		id = int32(v)
+	case int32:
+		id = v
+	case int64:
+		id = int32(v)
 	}
+	if id == 0 {
+		return nil
+	}
+	return []int32{id}
 }
 
 func (f *ModelField) Parse(raw interface{}) (interface{}, error) {
@@ -158,30 +271,7 @@ func (f *ModelField) ExportForFrontend(ctx *uicontext.UiContext, value interface
 	result["type"] = "object"
 
 	// Export options as list of {id, name} (loaded from LoadOptions or List field)
-	options := f.Options
-	if len(options) == 0 && len(f.List) > 0 {
-		options = f.List
-	}
-
-	// Convert options to frontend format, filtering out Sub IDs
-	exportedOptions := make([]map[string]interface{}, 0, len(options))
-	for _, opt := range options {
-		// Skip if in Sub (subtract) list
-		skip := false
-		for _, subID := range f.Sub {
-			if opt.ID == subID {
-				skip = true
-				break
-			}
-		}
-		if !skip {
-			exportedOptions = append(exportedOptions, map[string]interface{}{
-				"id":   opt.ID,
-				"name": opt.Name,
-			})
-		}
-	}
-	result["list"] = exportedOptions
+	result["list"] = f.ExportOptions()
 
 	// Add URL and params if specified
 	if f.URL != "" {
@@ -194,6 +284,20 @@ func (f *ModelField) ExportForFrontend(ctx *uicontext.UiContext, value interface
 	// Add search flag
 	result["search"] = f.AllowSearch
 
+	// Remote options: list only contains the selected option, search through url
+	if f.PagedLoader != nil {
+		result["remote"] = true
+		result["pageSize"] = DefaultModelOptionsPageSize
+	}
+
+	// Dependent options: reloaded from dependsOnUrl when the parent changes
+	if f.DependsOn != "" {
+		result["dependsOn"] = f.DependsOn
+		if f.DependsOnURL != "" {
+			result["dependsOnUrl"] = f.DependsOnURL
+		}
+	}
+
 	return result
 }
 
@@ -201,6 +305,36 @@ func (f *ModelField) ExportForFrontend(ctx *uicontext.UiContext, value interface
 // Chainable Setter Methods
 // ============================================================================
 
+// SetRemoteOptions switches the field to remote options: the frontend searches
+// the options page by page through url (see echoadapter.ModelOptions) and submitted
+// IDs are resolved through loader on bind
+//
+// Example:
+//
+//	vehicle := field.NewModelField("vehicle", "VEHICLE", true, "device", 0).
+//	    SetRemoteOptions("/Portal/Trip/Options/vehicle", deviceLoader)
+func (f *ModelField) SetRemoteOptions(url string, loader PagedModelLoader) *ModelField {
+	f.URL = url
+	f.PagedLoader = loader
+	f.AllowSearch = true
+	return f
+}
+
+// SetDependsOn makes the options depend on the value of the parent field: loader
+// loads the options for a parent value and url returns them to the frontend when
+// the parent changes (see echoadapter.DependentOptions)
+//
+// Example:
+//
+//	vehicle := field.NewModelField("vehicle", "VEHICLE", true, "device", 0).
+//	    SetDependsOn("group", "/Portal/Trip/Options/vehicle", loadGroupDevices)
+func (f *ModelField) SetDependsOn(parentID, url string, loader DependentModelLoaderFunc) *ModelField {
+	f.DependsOn = parentID
+	f.DependsOnURL = url
+	f.DependentLoader = loader
+	return f
+}
+
 // SetClass sets the CSS class for frontend styling
 func (f *ModelField) SetClass(class string) *ModelField {
 	f.BaseField.SetClass(class)
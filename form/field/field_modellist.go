@@ -10,25 +10,37 @@ import (
 // This is used to select multiple objects from a list
 type ModelListField struct {
 	*BaseField
-	ModelType  string                 // Type of model (e.g., "device", "driver", "group")
-	URL        string                 // API endpoint to fetch options (for frontend)
-	List       []ModelOption          // Predefined list of options
-	Filter     map[string]interface{} // Additional filter parameters
-	Add        []ModelOption          // Additional options to add to the list
-	Sub        []int32                // Subtract/remove specific IDs from list
-	MinItems   *int                   // Minimum number of selections
-	MaxItems   *int                   // Maximum number of selections
-	Params     map[string]interface{} // Additional parameters for API call
-	LoaderFunc ModelLoaderFunc        // Function to load options from database
-	Options    []ModelOption          // Loaded options (populated by LoadOptions)
-	AllowEmpty bool                   // If true, empty selection is allowed
-	SingleOnly bool                   // If true, only one item can be selected
-	Value      ModelListValue         // Parsed and validated value (type-safe access)
+	ModelType   string                 // Type of model (e.g., "device", "driver", "group")
+	URL         string                 // API endpoint to fetch options (for frontend)
+	List        []ModelOption          // Predefined list of options
+	Filter      map[string]interface{} // Additional filter parameters
+	Add         []ModelOption          // Additional options to add to the list
+	Sub         []int32                // Subtract/remove specific IDs from list
+	MinItems    *int                   // Minimum number of selections
+	MaxItems    *int                   // Maximum number of selections
+	Params      map[string]interface{} // Additional parameters for API call
+	LoaderFunc  ModelLoaderFunc        // Function to load options from database
+	PagedLoader PagedModelLoader       // Remote options loader (see SetRemoteOptions)
+	Options     []ModelOption          // Loaded options (populated by LoadOptions)
+	AllowEmpty  bool                   // If true, empty selection is allowed
+	SingleOnly  bool                   // If true, only one item can be selected
+	Value       ModelListValue         // Parsed and validated value (type-safe access)
+	ctx         *uicontext.UiContext   // Context for resolving submitted IDs (set by BindContext)
 }
 
 // LoadOptions implements FieldOptionsLoader interface
-// Loads model options using the configured LoaderFunc
+// Loads model options using the configured LoaderFunc. With remote options only
+// the selected options (current value) are loaded, so the frontend can show them.
 func (f *ModelListField) LoadOptions(ctx *uicontext.UiContext) error {
+	if f.PagedLoader != nil {
+		options, err := f.ResolveOptions(ctx, f.selectedIDs(f.GetDefault()))
+		if err != nil {
+			return fmt.Errorf("loading options for modellist field %s: %w", f.ID, err)
+		}
+		f.Options = options
+		return nil
+	}
+
 	if f.LoaderFunc == nil {
 		// No loader function = options are static or loaded externally
 		return nil
@@ -48,38 +60,77 @@ func (f *ModelListField) SetLoaderFunc(loader ModelLoaderFunc) {
 	f.LoaderFunc = loader
 }
 
+// BindContext keeps the user context for resolving submitted IDs with remote options.
+func (f *ModelListField) BindContext(ctx *uicontext.UiContext) {
+	f.ctx = ctx
+}
+
+// HasRemoteOptions returns true if the field uses remote options
+func (f *ModelListField) HasRemoteOptions() bool {
+	return f.PagedLoader != nil
+}
+
+// SearchOptions returns one page of remote options for a search term
+func (f *ModelListField) SearchOptions(ctx *uicontext.UiContext, search string, page, pageSize int) (ModelOptionsPage, error) {
+	return searchRemoteOptions(f.PagedLoader, ctx, ModelOptionsQuery{
+		ModelType: f.ModelType,
+		Search:    search,
+		Page:      page,
+		PageSize:  pageSize,
+		Filter:    f.Filter,
+	}, f.Sub)
+}
+
+// ResolveOptions returns the remote options with the given IDs
+func (f *ModelListField) ResolveOptions(ctx *uicontext.UiContext, ids []int32) ([]ModelOption, error) {
+	return resolveRemoteOptions(f.PagedLoader, ctx, f.ModelType, ids, f.Sub)
+}
+
 func (f *ModelListField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required && !f.AllowEmpty {
-			return fmt.Errorf("modellist field %s is required", f.ID)
+			return requiredError("modellist", f.ID)
 		}
 		return nil
 	}
 
 	list, ok := value.(ModelListValue)
 	if !ok {
-		return fmt.Errorf("invalid modellist value type for %s", f.ID)
+		return invalidTypeError("modellist", f.ID)
 	}
 
 	if !f.AllowEmpty && len(list) == 0 && f.Required {
-		return fmt.Errorf("modellist %s cannot be empty", f.ID)
+		return NewFieldError(f.ID, ErrCodeRequired, nil, "modellist %s cannot be empty", f.ID)
 	}
 
 	if f.MinItems != nil && len(list) < *f.MinItems {
-		return fmt.Errorf("modellist %s must have at least %d items", f.ID, *f.MinItems)
+		return NewFieldError(f.ID, ErrCodeMinItems, map[string]interface{}{"min": *f.MinItems},
+			"modellist %s must have at least %d items", f.ID, *f.MinItems)
 	}
 
 	if f.MaxItems != nil && len(list) > *f.MaxItems {
-		return fmt.Errorf("modellist %s must have at most %d items", f.ID, *f.MaxItems)
+		return NewFieldError(f.ID, ErrCodeMaxItems, map[string]interface{}{"max": *f.MaxItems},
+			"modellist %s must have at most %d items", f.ID, *f.MaxItems)
 	}
 
 	if f.SingleOnly && len(list) > 1 {
-		return fmt.Errorf("modellist %s can only have one item", f.ID)
+		return NewFieldError(f.ID, ErrCodeMaxItems, map[string]interface{}{"max": 1}, "modellist %s can only have one item", f.ID)
+	}
+
+	// Remote options: all IDs must be known to the loader
+	if f.PagedLoader != nil && len(list) > 0 {
+		return checkRemoteOptions(f.PagedLoader, f.ctx, f.ID, f.ModelType, list, f.Sub)
 	}
 
 	return nil
 }
 
+// selectedIDs returns the selected IDs of a value.
+func (f *ModelListField) selectedIDs(value interface{}) []int32 {
+	list, _ := value.(ModelListValue)
+	return list
+}
+
 func (f *ModelListField) Parse(raw interface{}) (interface{}, error) {
 	// Reuse the helper function from types.go
 	return parseModelListValue(raw, f.GetDefault())
@@ -211,6 +262,12 @@ func (f *ModelListField) ExportForFrontend(ctx *uicontext.UiContext, value inter
 		result["max"] = *f.MaxItems
 	}
 
+	// Remote options: list only contains the selected options, search through url
+	if f.PagedLoader != nil {
+		result["remote"] = true
+		result["pageSize"] = DefaultModelOptionsPageSize
+	}
+
 	return result
 }
 
@@ -218,6 +275,20 @@ func (f *ModelListField) ExportForFrontend(ctx *uicontext.UiContext, value inter
 // Chainable Setter Methods
 // ============================================================================
 
+// SetRemoteOptions switches the field to remote options: the frontend searches
+// the options page by page through url (see echoadapter.ModelOptions) and submitted
+// IDs are resolved through loader on bind
+//
+// Example:
+//
+//	devices := field.NewDeviceListField("devices", "DEVICES", true, false).
+//	    SetRemoteOptions("/Portal/Report/Options/devices", deviceLoader)
+func (f *ModelListField) SetRemoteOptions(url string, loader PagedModelLoader) *ModelListField {
+	f.URL = url
+	f.PagedLoader = loader
+	return f
+}
+
 // SetClass sets the CSS class for frontend styling
 func (f *ModelListField) SetClass(class string) *ModelListField {
 	f.BaseField.SetClass(class)
@@ -0,0 +1,274 @@
+package field
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/xiriframework/xiri-go/types/locale"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// currencyMinorUnits lists ISO 4217 currencies whose minor unit is not 2 digits.
+var currencyMinorUnits = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0,
+	"PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// CurrencyMinorUnits returns the number of minor unit digits of an ISO 4217 currency
+// (e.g. 2 for EUR, 0 for JPY, 3 for KWD).
+func CurrencyMinorUnits(currency string) int {
+	if digits, ok := currencyMinorUnits[strings.ToUpper(currency)]; ok {
+		return digits
+	}
+	return 2
+}
+
+// MoneyField represents a money amount in a fixed currency, stored in minor units
+// (e.g. cents) to avoid floating point rounding.
+//
+// Strings and JSON numbers are amounts in major units ("1.234,56" for German users
+// = 123456 cents); Go integers are minor units (e.g. the current value).
+// Strings are parsed in the user's locale like DecimalField.
+type MoneyField struct {
+	*BaseField
+	Currency   string         // ISO 4217 currency code (e.g., "EUR")
+	MinorUnits int            // Digits of the minor unit (from CurrencyMinorUnits)
+	Min        *int64         // Minimum amount in minor units
+	Max        *int64         // Maximum amount in minor units
+	Locale     *locale.Locale // Explicit input/output locale (nil = user's locale from BindContext, else "1234.56")
+	Value      *int64         // Parsed and validated amount in minor units (type-safe access)
+	ctxLocale  *locale.Locale // User's locale, set by BindContext
+}
+
+// BindContext sets the user's locale, used unless Locale is set explicitly.
+func (f *MoneyField) BindContext(ctx *uicontext.UiContext) {
+	f.ctxLocale = contextLocale(ctx)
+}
+
+func (f *MoneyField) Validate(value interface{}) error {
+	if value == nil {
+		if f.Required {
+			return requiredError("money", f.ID)
+		}
+		return nil
+	}
+
+	amount, ok := value.(int64)
+	if !ok {
+		return invalidTypeError("money", f.ID)
+	}
+
+	if f.Min != nil && amount < *f.Min {
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"min": f.major(*f.Min), "currency": f.Currency},
+			"money field %s must be >= %s %s", f.ID, f.format(*f.Min, nil), f.Currency)
+	}
+
+	if f.Max != nil && amount > *f.Max {
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"max": f.major(*f.Max), "currency": f.Currency},
+			"money field %s must be <= %s %s", f.ID, f.format(*f.Max, nil), f.Currency)
+	}
+
+	return nil
+}
+
+// Parse converts the raw value into minor units (int64).
+func (f *MoneyField) Parse(raw interface{}) (interface{}, error) {
+	if raw == nil {
+		return f.GetDefault(), nil
+	}
+
+	switch v := raw.(type) {
+	case int:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case float64:
+		shifted := v * math.Pow10(f.MinorUnits)
+		if math.Abs(shifted-math.Round(shifted)) > 1e-6 {
+			return nil, f.scaleError()
+		}
+		return int64(math.Round(shifted)), nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil, nil
+		}
+		normalized, err := normalizeNumber(v, fieldLocale(f.Locale, f.ctxLocale))
+		if err != nil {
+			return nil, err
+		}
+		return f.toMinorUnits(normalized)
+	default:
+		return nil, fmt.Errorf("cannot parse money from %T", raw)
+	}
+}
+
+// BindValue parses, validates, and stores the value in the field
+func (f *MoneyField) BindValue(raw interface{}) error {
+	parsed, err := f.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("parsing field %s: %w", f.ID, err)
+	}
+
+	if err := f.Validate(parsed); err != nil {
+		return fmt.Errorf("validating field %s: %w", f.ID, err)
+	}
+
+	if parsed != nil {
+		if amount, ok := parsed.(int64); ok {
+			f.Value = &amount
+		}
+	} else {
+		f.Value = nil
+	}
+
+	return nil
+}
+
+// toMinorUnits converts a canonical decimal string ("-1234.5") into minor units without rounding.
+func (f *MoneyField) toMinorUnits(normalized string) (int64, error) {
+	intPart, fracPart, _ := strings.Cut(normalized, ".")
+	if len(fracPart) > f.MinorUnits {
+		return 0, f.scaleError()
+	}
+	fracPart += strings.Repeat("0", f.MinorUnits-len(fracPart))
+	amount, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid money value: %s", normalized)
+	}
+	return amount, nil
+}
+
+// scaleError returns the error for amounts with more decimals than the currency has.
+func (f *MoneyField) scaleError() *FieldError {
+	return NewFieldError(f.ID, ErrCodeScale, map[string]interface{}{"scale": f.MinorUnits},
+		"money field %s allows at most %d decimal places", f.ID, f.MinorUnits)
+}
+
+// major returns the amount in major units.
+func (f *MoneyField) major(amount int64) float64 {
+	return float64(amount) / math.Pow10(f.MinorUnits)
+}
+
+// format formats an amount in minor units in loc.
+func (f *MoneyField) format(amount int64, loc *locale.Locale) string {
+	return formatNumber(f.major(amount), f.MinorUnits, loc)
+}
+
+// ============================================================================
+// Builder Functions
+// ============================================================================
+
+// NewMoneyField creates a money form field; currentValue is in minor units (e.g. cents)
+//
+// Example:
+//
+//	price := field.NewMoneyField("price", "PRICE", true, 1999, "EUR") // 19,99 €
+func NewMoneyField(id, name string, required bool, currentValue int64, currency string) *MoneyField {
+	return &MoneyField{
+		BaseField: &BaseField{
+			ID:       id,
+			Type:     FieldTypeMoney,
+			Name:     name,
+			Required: required,
+			Default:  currentValue,
+			Form:     true,
+		},
+		Currency:   strings.ToUpper(currency),
+		MinorUnits: CurrencyMinorUnits(currency),
+	}
+}
+
+// ExportForFrontend exports the field for frontend rendering
+func (f *MoneyField) ExportForFrontend(ctx *uicontext.UiContext, value interface{}) map[string]interface{} {
+	if value == nil {
+		value = f.GetDefault()
+	}
+	result := f.BaseField.GetBaseExport(ctx, value)
+
+	loc := exportLocale(ctx, f.Locale, f.ctxLocale)
+	if amount, ok := value.(int64); ok {
+		result["value"] = f.format(amount, loc)
+		result["raw"] = amount
+	}
+
+	result["currency"] = f.Currency
+	result["scale"] = f.MinorUnits
+	if f.Min != nil {
+		result["min"] = f.major(*f.Min)
+	}
+	if f.Max != nil {
+		result["max"] = f.major(*f.Max)
+	}
+	addSeparatorExport(result, loc)
+
+	return result
+}
+
+// ============================================================================
+// Chainable Setter Methods
+// ============================================================================
+
+// SetMin sets the minimum amount in minor units
+func (f *MoneyField) SetMin(min int64) *MoneyField {
+	f.Min = &min
+	return f
+}
+
+// SetMax sets the maximum amount in minor units
+func (f *MoneyField) SetMax(max int64) *MoneyField {
+	f.Max = &max
+	return f
+}
+
+// SetLocale sets the input/output locale
+func (f *MoneyField) SetLocale(loc locale.Locale) *MoneyField {
+	f.Locale = &loc
+	return f
+}
+
+// SetClass sets the CSS class for frontend styling
+func (f *MoneyField) SetClass(class string) *MoneyField {
+	f.BaseField.SetClass(class)
+	return f
+}
+
+// SetHint sets the tooltip/help text for the field
+func (f *MoneyField) SetHint(hint string) *MoneyField {
+	f.BaseField.SetHint(hint)
+	return f
+}
+
+// SetStep sets the step indicator for multi-step forms
+func (f *MoneyField) SetStep(step int) *MoneyField {
+	f.BaseField.SetStep(step)
+	return f
+}
+
+// SetDisabled sets whether the field is disabled
+func (f *MoneyField) SetDisabled(disabled bool) *MoneyField {
+	f.BaseField.SetDisabled(disabled)
+	return f
+}
+
+// SetAccess sets the access control permissions
+func (f *MoneyField) SetAccess(access []string) *MoneyField {
+	f.BaseField.SetAccess(access)
+	return f
+}
+
+// SetScenario sets which scenarios this field applies to
+func (f *MoneyField) SetScenario(scenario []string) *MoneyField {
+	f.BaseField.SetScenario(scenario)
+	return f
+}
+
+// SetForm sets whether to show in form
+func (f *MoneyField) SetForm(form bool) *MoneyField {
+	f.BaseField.SetForm(form)
+	return f
+}
@@ -2,6 +2,7 @@ package field
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/xiriframework/xiri-go/uicontext"
 )
@@ -13,6 +14,11 @@ type SelectField struct {
 	Subtype string // Select subtype (e.g., "select", "radio", "checkbox")
 	Search  *bool  // Enable search filter (nil = auto based on option count, true/false = force)
 	Value   int32  // Parsed and validated value (type-safe access - always int32)
+
+	// Dependent options (see SetDependsOn)
+	DependsOn     string                  // ID of the parent field ("" = not dependent)
+	DependsOnURL  string                  // Endpoint returning the options for a parent value
+	OptionsLoader SelectOptionsLoaderFunc // Loads the options for the parent value
 }
 
 // SelectOption represents a single option in a select field
@@ -24,7 +30,7 @@ type SelectOption struct {
 func (f *SelectField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("select field %s is required", f.ID)
+			return requiredError("select", f.ID)
 		}
 		return nil
 	}
@@ -35,8 +41,12 @@ func (f *SelectField) Validate(value interface{}) error {
 			return nil
 		}
 	}
+	// Dependent fields keep unmatched raw values until the options are loaded
+	if _, ok := matchSelectOption(f.Options, value); ok && f.DependsOn != "" {
+		return nil
+	}
 
-	return fmt.Errorf("select field %s has invalid value", f.ID)
+	return NewFieldError(f.ID, ErrCodeInvalidOption, nil, "select field %s has invalid value", f.ID)
 }
 
 func (f *SelectField) Parse(raw interface{}) (interface{}, error) {
@@ -44,46 +54,66 @@ func (f *SelectField) Parse(raw interface{}) (interface{}, error) {
 		return f.GetDefault(), nil
 	}
 
-	// Try to match against options
-	for _, opt := range f.Options {
+	if value, ok := matchSelectOption(f.Options, raw); ok {
+		return value, nil
+	}
+	if f.DependsOn != "" {
+		// Checked by Validate once the options for the parent value are loaded
+		return raw, nil
+	}
+
+	return nil, NewFieldError(f.ID, ErrCodeInvalidOption, nil, "select field %s has no matching option for value %v", f.ID, raw)
+}
+
+// matchSelectOption returns the option value matching a raw value.
+// Numbers match options of any integer type; numeric strings match integer options.
+func matchSelectOption(options []SelectOption, raw interface{}) (interface{}, bool) {
+	for _, opt := range options {
 		switch optVal := opt.Value.(type) {
 		case string:
 			if str, ok := raw.(string); ok && str == optVal {
-				return optVal, nil
+				return optVal, true
 			}
 		case int:
 			if num, ok := raw.(float64); ok && int(num) == optVal {
-				return optVal, nil
+				return optVal, true
 			}
 			if num, ok := raw.(int); ok && num == optVal {
-				return optVal, nil
+				return optVal, true
 			}
 		case int32:
 			// Handle int32 options (common for database IDs)
 			if num, ok := raw.(float64); ok && int32(num) == optVal {
-				return optVal, nil
+				return optVal, true
 			}
 			if num, ok := raw.(int32); ok && num == optVal {
-				return optVal, nil
+				return optVal, true
 			}
 			if num, ok := raw.(int); ok && int32(num) == optVal {
-				return optVal, nil
+				return optVal, true
 			}
 		case int64:
 			// Handle int64 options
 			if num, ok := raw.(float64); ok && int64(num) == optVal {
-				return optVal, nil
+				return optVal, true
 			}
 			if num, ok := raw.(int64); ok && num == optVal {
-				return optVal, nil
+				return optVal, true
 			}
 			if num, ok := raw.(int); ok && int64(num) == optVal {
-				return optVal, nil
+				return optVal, true
 			}
 		}
 	}
 
-	return nil, fmt.Errorf("select field %s has no matching option for value %v", f.ID, raw)
+	// Numeric strings (form-urlencoded or query values) for integer options
+	if str, ok := raw.(string); ok {
+		if num, err := strconv.ParseInt(str, 10, 64); err == nil {
+			return matchSelectOption(options, float64(num))
+		}
+	}
+
+	return nil, false
 }
 
 // BindValue parses, validates, and stores the value in the field
@@ -96,6 +126,9 @@ func (f *SelectField) BindValue(raw interface{}) error {
 	if err := f.Validate(parsed); err != nil {
 		return fmt.Errorf("validating field %s: %w", f.ID, err)
 	}
+	if f.DependsOn != "" && parsed != nil {
+		parsed, _ = matchSelectOption(f.Options, parsed)
+	}
 
 	// Convert parsed value to int32
 	switch v := parsed.(type) {
@@ -119,6 +152,36 @@ func (f *SelectField) BindValue(raw interface{}) error {
 	return nil
 }
 
+// GetDependsOn returns the ID of the parent field ("" = not dependent)
+func (f *SelectField) GetDependsOn() string {
+	return f.DependsOn
+}
+
+// LoadDependentOptions loads the options for the parent value using OptionsLoader
+func (f *SelectField) LoadDependentOptions(ctx *uicontext.UiContext, parent interface{}) error {
+	if f.OptionsLoader == nil {
+		return nil
+	}
+	options, err := f.OptionsLoader(ctx, parent)
+	if err != nil {
+		return fmt.Errorf("loading options for select field %s: %w", f.ID, err)
+	}
+	f.Options = options
+	return nil
+}
+
+// ExportOptions returns the options as list of {id, name}
+func (f *SelectField) ExportOptions() []map[string]interface{} {
+	options := make([]map[string]interface{}, len(f.Options))
+	for i, opt := range f.Options {
+		options[i] = map[string]interface{}{
+			"id":   opt.Value,
+			"name": opt.Label, // Label should be translation key, FormGroup will translate
+		}
+	}
+	return options
+}
+
 // ============================================================================
 // Builder Functions
 // ============================================================================
@@ -157,14 +220,15 @@ func (f *SelectField) ExportForFrontend(ctx *uicontext.UiContext, value interfac
 
 	// Export options as array of {id, name} maps
 	// MUST be "list" not "options" to match frontend
-	options := make([]map[string]interface{}, len(f.Options))
-	for i, opt := range f.Options {
-		options[i] = map[string]interface{}{
-			"id":   opt.Value,
-			"name": opt.Label, // Label should be translation key, FormGroup will translate
+	result["list"] = f.ExportOptions()
+
+	// Dependent options: reloaded from dependsOnUrl when the parent changes
+	if f.DependsOn != "" {
+		result["dependsOn"] = f.DependsOn
+		if f.DependsOnURL != "" {
+			result["dependsOnUrl"] = f.DependsOnURL
 		}
 	}
-	result["list"] = options
 
 	// Set search based on option count (disable if < 20 options unless explicitly set)
 	if f.Search != nil {
@@ -180,6 +244,21 @@ func (f *SelectField) ExportForFrontend(ctx *uicontext.UiContext, value interfac
 // Chainable Setter Methods
 // ============================================================================
 
+// SetDependsOn makes the options depend on the value of the parent field: loader
+// loads the options for a parent value and url returns them to the frontend when
+// the parent changes (see echoadapter.DependentOptions)
+//
+// Example:
+//
+//	region := field.NewSelectField("region", "REGION", true, nil).
+//	    SetDependsOn("country", "/Portal/Address/Options/region", loadRegions)
+func (f *SelectField) SetDependsOn(parentID, url string, loader SelectOptionsLoaderFunc) *SelectField {
+	f.DependsOn = parentID
+	f.DependsOnURL = url
+	f.OptionsLoader = loader
+	return f
+}
+
 // SetClass sets the CSS class for frontend styling
 func (f *SelectField) SetClass(class string) *SelectField {
 	f.BaseField.SetClass(class)
@@ -2,6 +2,7 @@ package field
 
 import (
 	"fmt"
+	"unicode/utf8"
 
 	"github.com/xiriframework/xiri-go/uicontext"
 )
@@ -19,30 +20,42 @@ type TextField struct {
 	IconSuffix string  // Suffix icon name
 	Trim       bool    // Whether to trim whitespace (default: true)
 	Value      *string // Parsed and validated value (type-safe access)
+
+	URLSchemes     []string        // Accepted schemes for subtype "url" (nil = DefaultURLSchemes)
+	PasswordPolicy *PasswordPolicy // Strength rules for subtype "password" (nil = none)
+	PhoneCountry   string          // Country calling code for national numbers of subtype "tel" (e.g. "43", "" = E.164 input only)
 }
 
 func (f *TextField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("text field %s is required", f.ID)
+			return requiredError("text", f.ID)
 		}
 		return nil
 	}
 
 	str, ok := value.(string)
 	if !ok {
-		return fmt.Errorf("invalid text value type for %s", f.ID)
+		return invalidTypeError("text", f.ID)
 	}
 
-	if f.MinLength > 0 && len(str) < f.MinLength {
-		return fmt.Errorf("text field %s must be at least %d characters", f.ID, f.MinLength)
+	// Lengths are counted in characters, not bytes
+	length := utf8.RuneCountInString(str)
+	if f.MinLength > 0 && length < f.MinLength {
+		return NewFieldError(f.ID, ErrCodeMinLength, map[string]interface{}{"min": f.MinLength},
+			"text field %s must be at least %d characters", f.ID, f.MinLength)
 	}
 
-	if f.MaxLength > 0 && len(str) > f.MaxLength {
-		return fmt.Errorf("text field %s must be at most %d characters", f.ID, f.MaxLength)
+	if f.MaxLength > 0 && length > f.MaxLength {
+		return NewFieldError(f.ID, ErrCodeMaxLength, map[string]interface{}{"max": f.MaxLength},
+			"text field %s must be at most %d characters", f.ID, f.MaxLength)
 	}
 
-	return nil
+	// Empty optional values are not checked against pattern and subtype
+	if str == "" {
+		return nil
+	}
+	return f.validateFormat(str)
 }
 
 func (f *TextField) Parse(raw interface{}) (interface{}, error) {
@@ -51,6 +64,9 @@ func (f *TextField) Parse(raw interface{}) (interface{}, error) {
 	}
 
 	if str, ok := raw.(string); ok {
+		if f.Subtype == "tel" {
+			return normalizePhoneCountry(str, f.PhoneCountry), nil
+		}
 		return str, nil
 	}
 
@@ -135,6 +151,9 @@ func (f *TextField) ExportForFrontend(ctx *uicontext.UiContext, value interface{
 	if f.MaxLength > 0 {
 		result["max"] = f.MaxLength
 	}
+	if f.Pattern != "" {
+		result["pattern"] = f.Pattern
+	}
 
 	// Add prefix/suffix text and icons
 	if f.TextPrefix != "" {
@@ -198,3 +217,62 @@ func (f *TextField) SetForm(form bool) *TextField {
 	f.BaseField.SetForm(form)
 	return f
 }
+
+// SetPattern sets the regex the value must match (whole value). The pattern is exported
+// to the frontend as is and checked server-side if it is valid Go regexp (RE2) syntax;
+// JavaScript-only patterns (e.g. lookaheads) are checked by the frontend only, see PatternError.
+func (f *TextField) SetPattern(pattern string) *TextField {
+	f.Pattern = pattern
+	return f
+}
+
+// PatternError returns why Pattern cannot be checked server-side (not valid Go regexp
+// syntax), or nil. Use it in tests to make sure a pattern is enforced by the server.
+//
+// Example:
+//
+//	plate := field.NewTextField("plate", "PLATE", true, "").SetPattern(`[A-Z]{1,2}-[0-9]+`)
+//	if err := plate.PatternError(); err != nil {
+//	    t.Fatal(err)
+//	}
+func (f *TextField) PatternError() error {
+	if f.Pattern == "" {
+		return nil
+	}
+	if _, err := compilePattern(f.Pattern); err != nil {
+		return fmt.Errorf("text field %s: pattern %q is not checked server-side: %w", f.ID, f.Pattern, err)
+	}
+	return nil
+}
+
+// SetSubtype sets the text subtype ("text", "textarea", "html", "email", "url", "tel", "password").
+// "email", "url", "tel" and "password" are also validated server-side.
+func (f *TextField) SetSubtype(subtype string) *TextField {
+	f.Subtype = subtype
+	return f
+}
+
+// SetPhoneCountry sets the country calling code for subtype "tel". National numbers
+// with a leading 0 are converted into E.164 with this code; without it, numbers must
+// be entered with country code ("+43 ..." or "0043 ...").
+//
+// Example:
+//
+//	phone := field.NewTextField("phone", "PHONE", false, "").SetSubtype("tel").SetPhoneCountry("43")
+//	// "0664 1234567" is stored as "+436641234567"
+func (f *TextField) SetPhoneCountry(code string) *TextField {
+	f.PhoneCountry = code
+	return f
+}
+
+// SetURLSchemes sets the accepted URL schemes for subtype "url" (default: http, https)
+func (f *TextField) SetURLSchemes(schemes ...string) *TextField {
+	f.URLSchemes = schemes
+	return f
+}
+
+// SetPasswordPolicy sets the strength rules for subtype "password"
+func (f *TextField) SetPasswordPolicy(policy PasswordPolicy) *TextField {
+	f.PasswordPolicy = &policy
+	return f
+}
@@ -0,0 +1,161 @@
+package field
+
+import (
+	"errors"
+	"testing"
+)
+
+func textErrorCode(t *testing.T, err error) ErrorCode {
+	t.Helper()
+	if err == nil {
+		return ""
+	}
+	var fe *FieldError
+	if !errors.As(err, &fe) {
+		t.Fatalf("expected FieldError, got %T: %v", err, err)
+	}
+	return fe.Code
+}
+
+func TestTextField_Validate_RuneLength(t *testing.T) {
+	f := NewTextFieldWithLength("name", "NAME", false, "", 0, 5)
+
+	// 5 characters, 10 bytes
+	if err := f.Validate("äöüßé"); err != nil {
+		t.Errorf("expected 5 umlauts to pass max length 5, got %v", err)
+	}
+	if code := textErrorCode(t, f.Validate("äöüßéa")); code != ErrCodeMaxLength {
+		t.Errorf("expected maxLength, got %q", code)
+	}
+
+	f = NewTextFieldWithLength("name", "NAME", false, "", 3, 0)
+	if code := textErrorCode(t, f.Validate("äö")); code != ErrCodeMinLength {
+		t.Errorf("expected minLength, got %q", code)
+	}
+}
+
+func TestTextField_Validate_Pattern(t *testing.T) {
+	f := NewTextField("plate", "PLATE", false, "").SetPattern(`[A-Z]{1,2}-[0-9]+`)
+
+	if err := f.Validate("W-123"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	// Anchored: partial matches are rejected
+	if code := textErrorCode(t, f.Validate("xW-123x")); code != ErrCodePattern {
+		t.Errorf("expected pattern, got %q", code)
+	}
+	// Empty optional value is not checked
+	if err := f.Validate(""); err != nil {
+		t.Errorf("unexpected error for empty value: %v", err)
+	}
+
+	if err := f.PatternError(); err != nil {
+		t.Errorf("unexpected pattern error: %v", err)
+	}
+
+	// JavaScript-only patterns (lookaheads) are exported, but checked by the frontend only
+	js := NewTextField("js", "JS", false, "").SetPattern(`(?=.*[0-9]).+`)
+	if js.PatternError() == nil {
+		t.Error("expected pattern error for lookahead")
+	}
+	if err := js.Validate("abc"); err != nil {
+		t.Errorf("expected no server-side check for JavaScript pattern, got %v", err)
+	}
+	if exported := js.ExportForFrontend(nil, nil); exported["pattern"] != `(?=.*[0-9]).+` {
+		t.Errorf("expected pattern in export, got %v", exported["pattern"])
+	}
+}
+
+func TestTextField_Validate_Email(t *testing.T) {
+	f := NewTextField("email", "EMAIL", false, "").SetSubtype("email")
+
+	for _, valid := range []string{"max@example.com", "a.b+tag@sub.example.at"} {
+		if err := f.Validate(valid); err != nil {
+			t.Errorf("%s: unexpected error: %v", valid, err)
+		}
+	}
+	for _, invalid := range []string{"max", "max@", "Max <max@example.com>", "max@localhost", "max@@example.com"} {
+		if code := textErrorCode(t, f.Validate(invalid)); code != ErrCodeEmail {
+			t.Errorf("%s: expected email error, got %q", invalid, code)
+		}
+	}
+}
+
+func TestTextField_Validate_URL(t *testing.T) {
+	f := NewTextField("url", "URL", false, "").SetSubtype("url")
+
+	if err := f.Validate("https://example.com/path?x=1"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	for _, invalid := range []string{"example.com", "ftp://example.com", "javascript:alert(1)", "https://"} {
+		if code := textErrorCode(t, f.Validate(invalid)); code != ErrCodeURL {
+			t.Errorf("%s: expected url error, got %q", invalid, code)
+		}
+	}
+
+	f.SetURLSchemes("ftp")
+	if err := f.Validate("ftp://example.com"); err != nil {
+		t.Errorf("expected custom scheme to pass, got %v", err)
+	}
+}
+
+func TestTextField_Tel_Normalization(t *testing.T) {
+	f := NewTextField("phone", "PHONE", false, "").SetSubtype("tel")
+
+	if err := f.BindValue("0043 (664) 123-45-67"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.Value == nil || *f.Value != "+436641234567" {
+		t.Errorf("expected +436641234567, got %v", f.Value)
+	}
+
+	if code := textErrorCode(t, f.Validate(NormalizePhone("0664 1234567"))); code != ErrCodeTel {
+		t.Errorf("expected tel error for number without country code, got %q", code)
+	}
+
+	// National numbers with the field's country code
+	f.SetPhoneCountry("43")
+	if err := f.BindValue("0664 1234567"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *f.Value != "+436641234567" {
+		t.Errorf("expected +436641234567, got %s", *f.Value)
+	}
+	if err := f.BindValue("+49 30 123456"); err != nil || *f.Value != "+4930123456" {
+		t.Errorf("expected international number unchanged, got %v %v", *f.Value, err)
+	}
+}
+
+func TestTextField_Validate_Password(t *testing.T) {
+	f := NewTextField("pw", "PASSWORD", true, "").SetSubtype("password")
+
+	// No policy: anything goes
+	if err := f.Validate("a"); err != nil {
+		t.Errorf("unexpected error without policy: %v", err)
+	}
+
+	f.SetPasswordPolicy(DefaultPasswordPolicy)
+	tests := []struct {
+		value string
+		rule  string
+	}{
+		{"Ab1", "minLength"},
+		{"abcdefg1", "upper"},
+		{"ABCDEFG1", "lower"},
+		{"Abcdefgh", "digit"},
+		{"Abcdefg1", ""},
+	}
+	for _, tt := range tests {
+		err := f.Validate(tt.value)
+		if tt.rule == "" {
+			if err != nil {
+				t.Errorf("%s: unexpected error: %v", tt.value, err)
+			}
+			continue
+		}
+		var fe *FieldError
+		if !errors.As(err, &fe) || fe.Code != ErrCodePassword || fe.Params["rule"] != tt.rule {
+			t.Errorf("%s: expected password rule %s, got %v", tt.value, tt.rule, err)
+		}
+	}
+}
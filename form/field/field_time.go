@@ -19,12 +19,27 @@ type TimeField struct {
 	AllowFuture bool   // If false, only past dates are allowed
 	Subtype     string // Subtype: "date", "datetime", "time"
 	Value       *int64 // Parsed and validated value (Unix timestamp)
+
+	Location *time.Location // Explicit timezone for strings without offset (nil = user's timezone from BindContext, else UTC)
+	DateOnly bool           // Store a pure calendar date as midnight UTC (exported as midnight in the user's zone)
+
+	ctxLocation *time.Location // User's timezone, set by BindContext
+}
+
+// BindContext sets the user's timezone, used unless Location is set explicitly.
+func (f *TimeField) BindContext(ctx *uicontext.UiContext) {
+	f.ctxLocation = ContextLocation(ctx)
+}
+
+// location returns the timezone for parsing (see fieldLocation).
+func (f *TimeField) location() *time.Location {
+	return fieldLocation(f.Location, f.ctxLocation)
 }
 
 func (f *TimeField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("time field %s is required", f.ID)
+			return requiredError("time", f.ID)
 		}
 		return nil
 	}
@@ -39,71 +54,69 @@ func (f *TimeField) Validate(value interface{}) error {
 	case time.Time:
 		t = v
 	default:
-		return fmt.Errorf("invalid time value type for %s", f.ID)
+		return invalidTypeError("time", f.ID)
 	}
 
 	if t.IsZero() {
-		return fmt.Errorf("time %s cannot be zero", f.ID)
+		return NewFieldError(f.ID, ErrCodeRequired, nil, "time %s cannot be zero", f.ID)
 	}
 
 	now := time.Now()
 	if !f.AllowPast && t.Before(now) {
-		return fmt.Errorf("time %s cannot be in the past", f.ID)
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"min": now.Unix()}, "time %s cannot be in the past", f.ID)
 	}
 
 	if !f.AllowFuture && t.After(now) {
-		return fmt.Errorf("time %s cannot be in the future", f.ID)
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"max": now.Unix()}, "time %s cannot be in the future", f.ID)
 	}
 
 	if f.MinDate != nil && t.Before(*f.MinDate) {
-		return fmt.Errorf("time %s is before minimum date", f.ID)
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"min": f.MinDate.Unix()}, "time %s is before minimum date", f.ID)
 	}
 
 	if f.MaxDate != nil && t.After(*f.MaxDate) {
-		return fmt.Errorf("time %s is after maximum date", f.ID)
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"max": f.MaxDate.Unix()}, "time %s is after maximum date", f.ID)
 	}
 
 	return nil
 }
 
+// Parse converts the raw value into a Unix timestamp (int64).
+// Strings without offset are wall clock times in Location (see LocalTime);
+// with DateOnly, the calendar date in Location is stored as midnight UTC.
 func (f *TimeField) Parse(raw interface{}) (interface{}, error) {
 	if raw == nil {
 		return f.GetDefault(), nil
 	}
 
 	// Parse various time formats
+	var t time.Time
 	switch v := raw.(type) {
 	case string:
-		// Try ISO date format first
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			return t.Unix(), nil
+		parsed, err := parseTimeString(v, f.Format, f.location())
+		if err != nil {
+			return nil, err
 		}
-		// Try ISO datetime format
-		if t, err := time.Parse(time.RFC3339, v); err == nil {
-			return t.Unix(), nil
-		}
-		// Try custom format if specified
-		if f.Format != "" {
-			if t, err := time.Parse(f.Format, v); err == nil {
-				return t.Unix(), nil
-			}
-		}
-		return nil, fmt.Errorf("invalid date string format: %s", v)
-
+		t = parsed
 	case int:
-		return int64(v), nil
+		t = time.Unix(int64(v), 0)
 	case int32:
-		return int64(v), nil
+		t = time.Unix(int64(v), 0)
 	case int64:
-		return v, nil
+		t = time.Unix(v, 0)
 	case float64:
-		return int64(v), nil
+		t = time.Unix(int64(v), 0)
 	case time.Time:
-		return v.Unix(), nil
+		t = v
 
 	default:
 		return nil, fmt.Errorf("unsupported time type: %T", v)
 	}
+
+	if f.DateOnly {
+		t = calendarDate(t, f.location())
+	}
+	return t.Unix(), nil
 }
 
 // BindValue parses, validates, and stores the value in the field
@@ -166,23 +179,24 @@ func (f *TimeField) ExportForFrontend(ctx *uicontext.UiContext, value interface{
 	}
 	result["subtype"] = f.Subtype
 
+	// Pure calendar dates are shown as midnight in the user's timezone
+	loc := exportLocation(ctx, f.Location, f.ctxLocation)
+	if f.DateOnly {
+		switch v := value.(type) {
+		case int64:
+			result["value"] = localMidnight(time.Unix(v, 0), loc).Unix()
+		case int:
+			result["value"] = localMidnight(time.Unix(int64(v), 0), loc).Unix()
+		}
+	}
+
 	// Add min/max with day offset handling (same as TimeRangeField)
 	// Days offset is calculated from midnight in user's timezone
 	if f.Min != nil {
 		minVal := *f.Min
 		// If value is between -10000 and 10000, treat as days offset from midnight today
 		if minVal > -10000 && minVal < 10000 {
-			// Get user's timezone
-			loc, err := time.LoadLocation(ctx.Timezone.GetIANA())
-			if err != nil {
-				loc = time.UTC
-			}
-			// Calculate midnight today in user's timezone, then add days offset
-			// This ensures DST transitions are handled correctly
-			now := time.Now().In(loc)
-			midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
-			targetDate := midnight.AddDate(0, 0, int(minVal))
-			result["min"] = targetDate.Unix()
+			result["min"] = dayOffset(minVal, loc)
 		} else {
 			result["min"] = minVal
 		}
@@ -191,17 +205,7 @@ func (f *TimeField) ExportForFrontend(ctx *uicontext.UiContext, value interface{
 		maxVal := *f.Max
 		// If value is between -10000 and 10000, treat as days offset from midnight today
 		if maxVal > -10000 && maxVal < 10000 {
-			// Get user's timezone
-			loc, err := time.LoadLocation(ctx.Timezone.GetIANA())
-			if err != nil {
-				loc = time.UTC
-			}
-			// Calculate midnight today in user's timezone, then add days offset
-			// This ensures DST transitions are handled correctly
-			now := time.Now().In(loc)
-			midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
-			targetDate := midnight.AddDate(0, 0, int(maxVal))
-			result["max"] = targetDate.Unix()
+			result["max"] = dayOffset(maxVal, loc)
 		} else {
 			result["max"] = maxVal
 		}
@@ -255,3 +259,15 @@ func (f *TimeField) SetForm(form bool) *TimeField {
 	f.BaseField.SetForm(form)
 	return f
 }
+
+// SetLocation sets the timezone for parsing strings without offset
+func (f *TimeField) SetLocation(loc *time.Location) *TimeField {
+	f.Location = loc
+	return f
+}
+
+// SetDateOnly stores the value as a pure calendar date (midnight UTC)
+func (f *TimeField) SetDateOnly(dateOnly bool) *TimeField {
+	f.DateOnly = dateOnly
+	return f
+}
@@ -158,3 +158,108 @@ func TestTimeFieldMinMaxDifferentTimezones(t *testing.T) {
 		})
 	}
 }
+
+func TestTimeField_ParseInUserTimezone(t *testing.T) {
+	f := NewTimeField("start", "START", true, 0)
+	f.BindContext(&uicontext.UiContext{Timezone: timezone.EuropeBerlin})
+
+	got, err := f.Parse("2026-03-01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := time.Date(2026, 2, 28, 23, 0, 0, 0, time.UTC).Unix()
+	if got != want {
+		t.Errorf("expected midnight Berlin (%d), got %v", want, got)
+	}
+
+	// Strings with offset keep it
+	got, _ = f.Parse("2026-03-01T10:00:00Z")
+	if got != time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC).Unix() {
+		t.Errorf("unexpected RFC 3339 result: %v", got)
+	}
+
+	// Datetime without offset is local time
+	got, _ = f.Parse("2026-07-01 08:30")
+	if got != time.Date(2026, 7, 1, 6, 30, 0, 0, time.UTC).Unix() {
+		t.Errorf("unexpected local datetime result: %v", got)
+	}
+
+	// Without context: UTC (unchanged behaviour)
+	got, _ = NewTimeField("start", "START", true, 0).Parse("2026-03-01")
+	if got != time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC).Unix() {
+		t.Errorf("expected UTC midnight without context, got %v", got)
+	}
+}
+
+func TestTimeField_ExplicitLocation(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	berlin := &uicontext.UiContext{Timezone: timezone.EuropeBerlin}
+
+	// The explicit location wins over the user's timezone on export and parse
+	f := NewTimeField("birthday", "BIRTHDAY", true, 0).SetDateOnly(true).SetLocation(tokyo)
+	f.BindContext(berlin)
+	date := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC).Unix()
+	if got := f.ExportForFrontend(berlin, date)["value"]; got != time.Date(2026, 3, 1, 0, 0, 0, 0, tokyo).Unix() {
+		t.Errorf("expected midnight Tokyo, got %v", got)
+	}
+
+	// Without explicit location, a new context replaces the bound one
+	g := NewTimeField("start", "START", true, 0)
+	g.BindContext(berlin)
+	g.BindContext(&uicontext.UiContext{Timezone: timezone.UTC})
+	if got, _ := g.Parse("2026-03-01"); got != date {
+		t.Errorf("expected timezone of the latest context, got %v", got)
+	}
+}
+
+func TestLocalTime_DST(t *testing.T) {
+	vienna, err := time.LoadLocation("Europe/Vienna")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Gap: 02:30 does not exist on 2026-03-29, moved forward to 03:30 CEST
+	gap := LocalTime(2026, time.March, 29, 2, 30, 0, vienna)
+	if gap.Hour() != 3 || gap.Minute() != 30 || !gap.Equal(time.Date(2026, 3, 29, 1, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected gap resolution: %v", gap)
+	}
+
+	// Overlap: 02:30 exists twice on 2026-10-25, the earlier (CEST) instant is used
+	overlap := LocalTime(2026, time.October, 25, 2, 30, 0, vienna)
+	if !overlap.Equal(time.Date(2026, 10, 25, 0, 30, 0, 0, time.UTC)) {
+		t.Errorf("unexpected overlap resolution: %v", overlap)
+	}
+
+	// Regular time
+	regular := LocalTime(2026, time.January, 10, 12, 0, 0, vienna)
+	if !regular.Equal(time.Date(2026, 1, 10, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected regular time: %v", regular)
+	}
+}
+
+func TestTimeField_DateOnlyRoundTrip(t *testing.T) {
+	ctx := &uicontext.UiContext{Timezone: timezone.EuropeBerlin}
+	f := NewTimeField("birthday", "BIRTHDAY", true, 0).SetDateOnly(true)
+	f.BindContext(ctx)
+
+	stored, err := f.Parse("2026-03-01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if stored != time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC).Unix() {
+		t.Fatalf("expected midnight UTC calendar date, got %v", stored)
+	}
+
+	// Export as midnight in the user's zone, parse the exported timestamp back
+	exported := f.ExportForFrontend(ctx, stored)["value"].(int64)
+	if exported != time.Date(2026, 2, 28, 23, 0, 0, 0, time.UTC).Unix() {
+		t.Errorf("expected export at midnight Berlin, got %d", exported)
+	}
+	again, _ := f.Parse(float64(exported))
+	if again != stored {
+		t.Errorf("round trip changed the date: %v -> %v", stored, again)
+	}
+}
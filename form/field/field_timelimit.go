@@ -50,14 +50,14 @@ func NewTimeLimitField(id, name string, required bool) *TimeLimitField {
 func (f *TimeLimitField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("timelimit field %s is required", f.ID)
+			return requiredError("timelimit", f.ID)
 		}
 		return nil
 	}
 
 	tl, ok := value.(TimeLimitValue)
 	if !ok {
-		return fmt.Errorf("invalid timelimit value type for %s", f.ID)
+		return invalidTypeError("timelimit", f.ID)
 	}
 
 	// Validate hour/minute ranges if check is enabled
@@ -69,16 +69,16 @@ func (f *TimeLimitField) Validate(value interface{}) error {
 		toMin := parseHourMin(tl.ToMin)
 
 		if fromHour < 0 || fromHour > 23 {
-			return fmt.Errorf("timelimit %s: fromhour must be 0-23", f.ID)
+			return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"min": 0, "max": 23}, "timelimit %s: fromhour must be 0-23", f.ID)
 		}
 		if fromMin < 0 || fromMin > 55 {
-			return fmt.Errorf("timelimit %s: frommin must be 0-55", f.ID)
+			return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"min": 0, "max": 55}, "timelimit %s: frommin must be 0-55", f.ID)
 		}
 		if toHour < 0 || toHour > 24 {
-			return fmt.Errorf("timelimit %s: tohour must be 0-24", f.ID)
+			return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"min": 0, "max": 24}, "timelimit %s: tohour must be 0-24", f.ID)
 		}
 		if toMin < 0 || toMin > 55 {
-			return fmt.Errorf("timelimit %s: tomin must be 0-55", f.ID)
+			return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"min": 0, "max": 55}, "timelimit %s: tomin must be 0-55", f.ID)
 		}
 	}
 
@@ -15,6 +15,21 @@ type TimeRangeField struct {
 	Min            *int64          // Minimum date (Unix timestamp) - can be days offset or absolute timestamp
 	Max            *int64          // Maximum date (Unix timestamp) - can be days offset or absolute timestamp
 	Value          *TimeRangeValue // Parsed and validated value (type-safe access)
+
+	Location *time.Location // Explicit timezone for strings without offset (nil = user's timezone from BindContext, else UTC)
+	DateOnly bool           // Store pure calendar dates as midnight UTC (exported as midnight in the user's zone)
+
+	ctxLocation *time.Location // User's timezone, set by BindContext
+}
+
+// BindContext sets the user's timezone, used unless Location is set explicitly.
+func (f *TimeRangeField) BindContext(ctx *uicontext.UiContext) {
+	f.ctxLocation = ContextLocation(ctx)
+}
+
+// location returns the timezone for parsing (see fieldLocation).
+func (f *TimeRangeField) location() *time.Location {
+	return fieldLocation(f.Location, f.ctxLocation)
 }
 
 // TimeRangeValue represents a parsed time range
@@ -26,33 +41,36 @@ type TimeRangeValue struct {
 func (f *TimeRangeField) Validate(value interface{}) error {
 	if value == nil {
 		if f.Required {
-			return fmt.Errorf("timerange field %s is required", f.ID)
+			return requiredError("timerange", f.ID)
 		}
 		return nil
 	}
 
 	tr, ok := value.(*TimeRangeValue)
 	if !ok {
-		return fmt.Errorf("invalid timerange value type for %s", f.ID)
+		return invalidTypeError("timerange", f.ID)
 	}
 
 	if tr.Start.IsZero() || tr.End.IsZero() {
-		return fmt.Errorf("timerange %s cannot have zero dates", f.ID)
+		return NewFieldError(f.ID, ErrCodeRequired, nil, "timerange %s cannot have zero dates", f.ID)
 	}
 
 	if f.AllowSingleDay {
 		if tr.Start.After(tr.End) {
-			return fmt.Errorf("timerange %s start cannot be after end", f.ID)
+			return NewFieldError(f.ID, ErrCodeRange, nil, "timerange %s start cannot be after end", f.ID)
 		}
 	} else {
 		if !tr.Start.Before(tr.End) {
-			return fmt.Errorf("timerange %s start must be before end", f.ID)
+			return NewFieldError(f.ID, ErrCodeRange, nil, "timerange %s start must be before end", f.ID)
 		}
 	}
 
 	return nil
 }
 
+// Parse converts a map with "start" and "end" into a *TimeRangeValue.
+// Strings without offset are wall clock times in Location; the times are returned
+// in Location, or as midnight UTC calendar dates with DateOnly.
 func (f *TimeRangeField) Parse(raw interface{}) (interface{}, error) {
 	if raw == nil {
 		return f.GetDefault(), nil
@@ -65,18 +83,22 @@ func (f *TimeRangeField) Parse(raw interface{}) (interface{}, error) {
 	}
 
 	// Parse start date (support Unix timestamp or ISO string)
-	start, err := parseDateTime(m["start"])
+	start, err := parseDateTime(m["start"], f.location())
 	if err != nil {
 		return nil, fmt.Errorf("timerange field missing or invalid start date: %w", err)
 	}
 
 	// Parse end date (support Unix timestamp or ISO string)
-	end, err := parseDateTime(m["end"])
+	end, err := parseDateTime(m["end"], f.location())
 	if err != nil {
 		return nil, fmt.Errorf("timerange field missing or invalid end date: %w", err)
 	}
 
-	return &TimeRangeValue{Start: start, End: end}, nil
+	if f.DateOnly {
+		return &TimeRangeValue{Start: calendarDate(start, f.location()), End: calendarDate(end, f.location())}, nil
+	}
+	loc := locationOrUTC(f.location())
+	return &TimeRangeValue{Start: start.In(loc), End: end.In(loc)}, nil
 }
 
 // BindValue parses, validates, and stores the value in the field
@@ -123,11 +145,17 @@ func (f *TimeRangeField) ExportForFrontend(ctx *uicontext.UiContext, value inter
 		}
 	}
 
+	loc := exportLocation(ctx, f.Location, f.ctxLocation)
+
 	// Use current time if no value
 	if start.IsZero() || end.IsZero() {
 		now := time.Now()
 		start = now
 		end = now
+	} else if f.DateOnly {
+		// Pure calendar dates are shown as midnight in the user's timezone
+		start = localMidnight(start, loc)
+		end = localMidnight(end, loc)
 	}
 
 	// Export as Unix timestamps (seconds)
@@ -139,18 +167,18 @@ func (f *TimeRangeField) ExportForFrontend(ctx *uicontext.UiContext, value inter
 	// Add min/max if specified
 	if f.Min != nil {
 		minVal := *f.Min
-		// Day offsets: if between -10000 and 10000, treat as days from now
+		// Day offsets: if between -10000 and 10000, treat as days from midnight today
 		if minVal > -10000 && minVal < 10000 {
-			result["min"] = time.Now().Unix() + (minVal * 86400) // 86400 = seconds per day
+			result["min"] = dayOffset(minVal, loc)
 		} else {
 			result["min"] = minVal
 		}
 	}
 	if f.Max != nil {
 		maxVal := *f.Max
-		// Day offsets: if between -10000 and 10000, treat as days from now
+		// Day offsets: if between -10000 and 10000, treat as days from midnight today
 		if maxVal > -10000 && maxVal < 10000 {
-			result["max"] = time.Now().Unix() + (maxVal * 86400)
+			result["max"] = dayOffset(maxVal, loc)
 		} else {
 			result["max"] = maxVal
 		}
@@ -245,3 +273,15 @@ func (f *TimeRangeField) SetForm(form bool) *TimeRangeField {
 	f.BaseField.SetForm(form)
 	return f
 }
+
+// SetLocation sets the timezone for parsing strings without offset
+func (f *TimeRangeField) SetLocation(loc *time.Location) *TimeRangeField {
+	f.Location = loc
+	return f
+}
+
+// SetDateOnly stores the range as pure calendar dates (midnight UTC)
+func (f *TimeRangeField) SetDateOnly(dateOnly bool) *TimeRangeField {
+	f.DateOnly = dateOnly
+	return f
+}
@@ -4,6 +4,9 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	"github.com/xiriframework/xiri-go/types/timezone"
+	"github.com/xiriframework/xiri-go/uicontext"
 )
 
 func TestTimeRangeField_Validate_Required(t *testing.T) {
@@ -186,3 +189,37 @@ func TestTimeRangeField_BindValue(t *testing.T) {
 		t.Errorf("expected end %v, got %v", expectedEnd, f.Value.End)
 	}
 }
+
+func TestTimeRangeField_ParseInUserTimezone(t *testing.T) {
+	ctx := &uicontext.UiContext{Timezone: timezone.EuropeBerlin}
+	f := NewTimeRangeField("period", "PERIOD", true)
+	f.BindContext(ctx)
+
+	parsed, err := f.Parse(map[string]interface{}{"start": "2026-03-01", "end": "2026-03-31"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	tr := parsed.(*TimeRangeValue)
+	if !tr.Start.Equal(time.Date(2026, 2, 28, 23, 0, 0, 0, time.UTC)) || !tr.End.Equal(time.Date(2026, 3, 30, 22, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected range: %v - %v", tr.Start, tr.End)
+	}
+	if tr.Start.Location().String() != "Europe/Berlin" {
+		t.Errorf("expected values in user location, got %s", tr.Start.Location())
+	}
+
+	// Date only: stored as midnight UTC, exported as midnight Berlin
+	f.SetDateOnly(true)
+	parsed, _ = f.Parse(map[string]interface{}{"start": "2026-03-01", "end": "2026-03-31"})
+	tr = parsed.(*TimeRangeValue)
+	if !tr.Start.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected calendar date, got %v", tr.Start)
+	}
+	value := f.ExportForFrontend(ctx, tr)["value"].(map[string]int64)
+	if value["start"] != time.Date(2026, 2, 28, 23, 0, 0, 0, time.UTC).Unix() {
+		t.Errorf("unexpected exported start: %d", value["start"])
+	}
+	again, _ := f.Parse(map[string]interface{}{"start": float64(value["start"]), "end": float64(value["end"])})
+	if r := again.(*TimeRangeValue); !r.Start.Equal(tr.Start) || !r.End.Equal(tr.End) {
+		t.Errorf("round trip changed the range: %v", r)
+	}
+}
@@ -0,0 +1,373 @@
+package field
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xiriframework/xiri-go/formatter"
+	"github.com/xiriframework/xiri-go/types/distance"
+	"github.com/xiriframework/xiri-go/types/locale"
+	"github.com/xiriframework/xiri-go/types/pressure"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// UnitKind is the physical quantity of a UnitField
+type UnitKind string
+
+const (
+	UnitKindDistance UnitKind = "distance" // Canonical unit km; km, mi or NM by UiContext.Distance
+	UnitKindSpeed    UnitKind = "speed"    // Canonical unit km/h; km/h, mph or kn by UiContext.Distance
+	UnitKindPressure UnitKind = "pressure" // Canonical unit bar; bar, psi or kPa by UiContext.Pressure
+)
+
+// UnitValue is a value in the canonical unit of a UnitField (km, km/h or bar).
+// It is used as the default value so it is not converted like user input.
+type UnitValue float64
+
+// UnitField represents a distance, speed or pressure in the user's unit.
+//
+// Values are stored in the canonical unit (km, km/h, bar). The field is shown
+// in the user's unit (UiContext.Distance / UiContext.Pressure, set by BindContext):
+// the current value is converted for display and the unit symbol is the suffix.
+// Submitted strings and JSON numbers are in the user's unit and are converted back
+// to the canonical unit; strings are parsed in the user's locale like DecimalField.
+// Min and Max are in the canonical unit.
+type UnitField struct {
+	*BaseField
+	Kind     UnitKind
+	Scale    int                // Decimals shown in the user's unit
+	Min      *float64           // Minimum value in the canonical unit
+	Max      *float64           // Maximum value in the canonical unit
+	Distance *distance.Distance // Explicit distance unit for distance and speed (nil = user's unit from BindContext, else km)
+	Pressure *pressure.Pressure // Explicit pressure unit (nil = user's unit from BindContext, else bar)
+	Locale   *locale.Locale     // Explicit input/output locale (nil = user's locale from BindContext, else "1234.56")
+	Value    *float64           // Parsed and validated value in the canonical unit (type-safe access)
+
+	ctxDistance *distance.Distance // User's distance unit, set by BindContext
+	ctxPressure *pressure.Pressure // User's pressure unit, set by BindContext
+	ctxLocale   *locale.Locale     // User's locale, set by BindContext
+}
+
+// BindContext sets the user's units and locale, used unless Distance, Pressure
+// or Locale are set explicitly.
+func (f *UnitField) BindContext(ctx *uicontext.UiContext) {
+	if ctx == nil {
+		return
+	}
+	dist, press := ctx.Distance, ctx.Pressure
+	f.ctxDistance = &dist
+	f.ctxPressure = &press
+	f.ctxLocale = contextLocale(ctx)
+}
+
+func (f *UnitField) Validate(value interface{}) error {
+	if value == nil {
+		if f.Required {
+			return requiredError(string(f.Kind), f.ID)
+		}
+		return nil
+	}
+
+	num, ok := unitFloat(value)
+	if !ok {
+		return invalidTypeError(string(f.Kind), f.ID)
+	}
+
+	unit := f.Symbol()
+	if f.Min != nil && num < *f.Min {
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"min": f.ToUserUnit(*f.Min), "unit": unit},
+			"%s field %s must be >= %s %s", f.Kind, f.ID, formatNumber(f.ToUserUnit(*f.Min), f.Scale, nil), unit)
+	}
+
+	if f.Max != nil && num > *f.Max {
+		return NewFieldError(f.ID, ErrCodeRange, map[string]interface{}{"max": f.ToUserUnit(*f.Max), "unit": unit},
+			"%s field %s must be <= %s %s", f.Kind, f.ID, formatNumber(f.ToUserUnit(*f.Max), f.Scale, nil), unit)
+	}
+
+	return nil
+}
+
+// Parse converts the raw value into a float64 in the canonical unit.
+// Strings and numbers are in the user's unit; UnitValue is taken as is.
+func (f *UnitField) Parse(raw interface{}) (interface{}, error) {
+	if raw == nil {
+		raw = f.GetDefault()
+		if raw == nil {
+			return nil, nil
+		}
+	}
+
+	switch v := raw.(type) {
+	case UnitValue:
+		return float64(v), nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil, nil
+		}
+		normalized, err := normalizeNumber(v, fieldLocale(f.Locale, f.ctxLocale))
+		if err != nil {
+			return nil, err
+		}
+		num, err := strconv.ParseFloat(normalized, 64)
+		if err != nil {
+			return nil, err
+		}
+		return f.FromUserUnit(num), nil
+	default:
+		if num, ok := toFloat(v); ok {
+			return f.FromUserUnit(num), nil
+		}
+		return nil, fmt.Errorf("cannot parse %s from %T", f.Kind, raw)
+	}
+}
+
+// BindValue parses, validates, and stores the value (in the canonical unit) in the field
+func (f *UnitField) BindValue(raw interface{}) error {
+	parsed, err := f.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("parsing field %s: %w", f.ID, err)
+	}
+
+	if err := f.Validate(parsed); err != nil {
+		return fmt.Errorf("validating field %s: %w", f.ID, err)
+	}
+
+	if num, ok := unitFloat(parsed); ok {
+		f.Value = &num
+	} else {
+		f.Value = nil
+	}
+
+	return nil
+}
+
+// ToUserUnit converts a value from the canonical unit into the user's unit
+func (f *UnitField) ToUserUnit(value float64) float64 {
+	switch f.Kind {
+	case UnitKindDistance:
+		return formatter.ConvertKmToDistance(value, f.distanceUnit())
+	case UnitKindSpeed:
+		return formatter.ConvertKmhToSpeed(value, f.distanceUnit())
+	case UnitKindPressure:
+		return formatter.ConvertBarToPressure(value, f.pressureUnit())
+	}
+	return value
+}
+
+// FromUserUnit converts a value from the user's unit into the canonical unit
+func (f *UnitField) FromUserUnit(value float64) float64 {
+	switch f.Kind {
+	case UnitKindDistance:
+		return formatter.ConvertDistanceToKm(value, f.distanceUnit())
+	case UnitKindSpeed:
+		return formatter.ConvertSpeedToKmh(value, f.distanceUnit())
+	case UnitKindPressure:
+		return formatter.ConvertPressureToBar(value, f.pressureUnit())
+	}
+	return value
+}
+
+// Symbol returns the symbol of the user's unit (e.g., "mi", "mph", "psi")
+func (f *UnitField) Symbol() string {
+	switch f.Kind {
+	case UnitKindDistance:
+		return f.distanceUnit().GetSymbol()
+	case UnitKindSpeed:
+		return formatter.SpeedSymbol(f.distanceUnit())
+	case UnitKindPressure:
+		return f.pressureUnit().GetSymbol()
+	}
+	return ""
+}
+
+// distanceUnit returns the explicit distance unit, else the user's unit (km if not bound).
+func (f *UnitField) distanceUnit() distance.Distance {
+	switch {
+	case f.Distance != nil:
+		return *f.Distance
+	case f.ctxDistance != nil:
+		return *f.ctxDistance
+	}
+	return distance.Kilometer
+}
+
+// pressureUnit returns the explicit pressure unit, else the user's unit (bar if not bound).
+func (f *UnitField) pressureUnit() pressure.Pressure {
+	switch {
+	case f.Pressure != nil:
+		return *f.Pressure
+	case f.ctxPressure != nil:
+		return *f.ctxPressure
+	}
+	return pressure.Bar
+}
+
+// unitFloat returns a canonical unit value as float64.
+func unitFloat(value interface{}) (float64, bool) {
+	if v, ok := value.(UnitValue); ok {
+		return float64(v), true
+	}
+	return toFloat(value)
+}
+
+// ============================================================================
+// Builder Functions
+// ============================================================================
+
+// newUnitField creates a unit field with the current value in the canonical unit.
+func newUnitField(id, name string, required bool, kind UnitKind, currentValue float64, scale int) *UnitField {
+	return &UnitField{
+		BaseField: &BaseField{
+			ID:       id,
+			Type:     FieldTypeDecimal,
+			Name:     name,
+			Required: required,
+			Default:  UnitValue(currentValue),
+			Form:     true,
+		},
+		Kind:  kind,
+		Scale: scale,
+	}
+}
+
+// NewDistanceField creates a distance field; the current value and Value are in km
+//
+// Example:
+//
+//	radius := field.NewDistanceField("radius", "RADIUS", true, 5).SetMin(0.1)
+func NewDistanceField(id, name string, required bool, currentKm float64) *UnitField {
+	return newUnitField(id, name, required, UnitKindDistance, currentKm, 1)
+}
+
+// NewSpeedField creates a speed field; the current value and Value are in km/h
+//
+// Example:
+//
+//	limit := field.NewSpeedField("speedLimit", "SPEED_LIMIT", true, 80).SetMin(1).SetMax(250)
+func NewSpeedField(id, name string, required bool, currentKmh float64) *UnitField {
+	return newUnitField(id, name, required, UnitKindSpeed, currentKmh, 0)
+}
+
+// NewPressureField creates a pressure field; the current value and Value are in bar
+//
+// Example:
+//
+//	tire := field.NewPressureField("tirePressure", "TIRE_PRESSURE", false, 2.5)
+func NewPressureField(id, name string, required bool, currentBar float64) *UnitField {
+	return newUnitField(id, name, required, UnitKindPressure, currentBar, 1)
+}
+
+// ExportForFrontend exports the field for frontend rendering in the user's unit
+func (f *UnitField) ExportForFrontend(ctx *uicontext.UiContext, value interface{}) map[string]interface{} {
+	if value == nil {
+		value = f.GetDefault()
+	}
+	result := f.BaseField.GetBaseExport(ctx, value)
+
+	// Explicit units, then the export context, then the units bound by BindContext
+	view := *f
+	view.BindContext(ctx)
+
+	loc := exportLocale(ctx, f.Locale, f.ctxLocale)
+	if num, ok := unitFloat(value); ok {
+		userValue := view.ToUserUnit(num)
+		result["value"] = formatNumber(userValue, f.Scale, loc)
+		result["raw"] = userValue
+	}
+
+	result["scale"] = f.Scale
+	if f.Min != nil {
+		result["min"] = view.ToUserUnit(*f.Min)
+	}
+	if f.Max != nil {
+		result["max"] = view.ToUserUnit(*f.Max)
+	}
+	result["unit"] = view.Symbol()
+	result["textSuffix"] = view.Symbol()
+	addSeparatorExport(result, loc)
+
+	return result
+}
+
+// ============================================================================
+// Chainable Setter Methods
+// ============================================================================
+
+// SetMin sets the minimum value in the canonical unit
+func (f *UnitField) SetMin(min float64) *UnitField {
+	f.Min = &min
+	return f
+}
+
+// SetMax sets the maximum value in the canonical unit
+func (f *UnitField) SetMax(max float64) *UnitField {
+	f.Max = &max
+	return f
+}
+
+// SetScale sets the decimals shown in the user's unit
+func (f *UnitField) SetScale(scale int) *UnitField {
+	f.Scale = scale
+	return f
+}
+
+// SetDistanceUnit sets the user's distance unit (overrides the context)
+func (f *UnitField) SetDistanceUnit(unit distance.Distance) *UnitField {
+	f.Distance = &unit
+	return f
+}
+
+// SetPressureUnit sets the user's pressure unit (overrides the context)
+func (f *UnitField) SetPressureUnit(unit pressure.Pressure) *UnitField {
+	f.Pressure = &unit
+	return f
+}
+
+// SetLocale sets the input/output locale
+func (f *UnitField) SetLocale(loc locale.Locale) *UnitField {
+	f.Locale = &loc
+	return f
+}
+
+// SetClass sets the CSS class for frontend styling
+func (f *UnitField) SetClass(class string) *UnitField {
+	f.BaseField.SetClass(class)
+	return f
+}
+
+// SetHint sets the tooltip/help text for the field
+func (f *UnitField) SetHint(hint string) *UnitField {
+	f.BaseField.SetHint(hint)
+	return f
+}
+
+// SetStep sets the step indicator for multi-step forms
+func (f *UnitField) SetStep(step int) *UnitField {
+	f.BaseField.SetStep(step)
+	return f
+}
+
+// SetDisabled sets whether the field is disabled
+func (f *UnitField) SetDisabled(disabled bool) *UnitField {
+	f.BaseField.SetDisabled(disabled)
+	return f
+}
+
+// SetAccess sets the access control permissions
+func (f *UnitField) SetAccess(access []string) *UnitField {
+	f.BaseField.SetAccess(access)
+	return f
+}
+
+// SetScenario sets which scenarios this field applies to
+func (f *UnitField) SetScenario(scenario []string) *UnitField {
+	f.BaseField.SetScenario(scenario)
+	return f
+}
+
+// SetForm sets whether to show in form
+func (f *UnitField) SetForm(form bool) *UnitField {
+	f.BaseField.SetForm(form)
+	return f
+}
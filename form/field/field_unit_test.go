@@ -0,0 +1,93 @@
+package field
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/xiriframework/xiri-go/types/distance"
+	"github.com/xiriframework/xiri-go/types/locale"
+	"github.com/xiriframework/xiri-go/types/pressure"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+func TestUnitField_BindConvertsToCanonicalUnit(t *testing.T) {
+	ctx := &uicontext.UiContext{Locale: locale.De, Distance: distance.Miles, Pressure: pressure.Psi}
+
+	tests := []struct {
+		field *UnitField
+		raw   interface{}
+		want  float64
+	}{
+		{NewSpeedField("limit", "LIMIT", true, 80), float64(50), 80.4672},
+		{NewSpeedField("limit", "LIMIT", true, 80), "50", 80.4672},
+		{NewDistanceField("radius", "RADIUS", true, 5), "1,5", 2.414016},
+		{NewPressureField("tire", "TIRE", true, 2.5), float64(36), 2.482108},
+		{NewSpeedField("limit", "LIMIT", false, 80), nil, 80}, // default is canonical
+	}
+	for _, tt := range tests {
+		tt.field.BindContext(ctx)
+		if err := tt.field.BindValue(tt.raw); err != nil {
+			t.Fatalf("%s %v: unexpected error: %v", tt.field.Kind, tt.raw, err)
+		}
+		if tt.field.Value == nil || math.Abs(*tt.field.Value-tt.want) > 1e-3 {
+			t.Errorf("%s %v: expected %v, got %v", tt.field.Kind, tt.raw, tt.want, tt.field.Value)
+		}
+	}
+}
+
+func TestUnitField_Validate(t *testing.T) {
+	f := NewSpeedField("limit", "LIMIT", true, 80).SetMin(1).SetMax(130).SetDistanceUnit(distance.Miles)
+
+	if err := f.BindValue(float64(70)); err != nil {
+		t.Errorf("70 mph: unexpected error: %v", err)
+	}
+	err := f.BindValue(float64(90)) // 144.8 km/h
+	var fe *FieldError
+	if !errors.As(err, &fe) || fe.Code != ErrCodeRange || fe.Params["unit"] != "mph" {
+		t.Errorf("90 mph: expected range error in mph, got %v", err)
+	}
+	if err := f.Validate(nil); err == nil {
+		t.Error("expected required error")
+	}
+}
+
+func TestUnitField_Export(t *testing.T) {
+	ctx := &uicontext.UiContext{Locale: locale.De, Distance: distance.Seemiles}
+	f := NewDistanceField("radius", "RADIUS", false, 10).SetMax(100)
+
+	result := f.ExportForFrontend(ctx, nil)
+	if result["value"] != "5,4" || result["textSuffix"] != "NM" {
+		t.Errorf("unexpected value/suffix: %v %v", result["value"], result["textSuffix"])
+	}
+	if max, _ := result["max"].(float64); math.Abs(max-53.9957) > 1e-6 {
+		t.Errorf("expected max in NM, got %v", result["max"])
+	}
+
+	bar := NewPressureField("tire", "TIRE", false, 2.5)
+	if result := bar.ExportForFrontend(nil, nil); result["value"] != "2.5" || result["unit"] != "bar" {
+		t.Errorf("unexpected export without context: %v %v", result["value"], result["unit"])
+	}
+}
+
+func TestUnitField_ExplicitUnit(t *testing.T) {
+	miles := &uicontext.UiContext{Locale: locale.EnUS, Distance: distance.Miles}
+	f := NewDistanceField("radius", "RADIUS", false, 10).SetDistanceUnit(distance.Kilometer)
+	f.BindContext(miles)
+
+	// The explicit unit wins over the user's unit on export and parse
+	if result := f.ExportForFrontend(miles, nil); result["value"] != "10.0" || result["unit"] != "km" {
+		t.Errorf("expected km export, got %v %v", result["value"], result["unit"])
+	}
+	if got, _ := f.Parse("10"); got != 10.0 {
+		t.Errorf("expected km input, got %v", got)
+	}
+
+	// Without explicit unit, a new context replaces the bound one
+	g := NewDistanceField("radius", "RADIUS", false, 10)
+	g.BindContext(miles)
+	g.BindContext(&uicontext.UiContext{Locale: locale.EnUS, Distance: distance.Kilometer})
+	if got, _ := g.Parse("10"); got != 10.0 {
+		t.Errorf("expected unit of the latest context, got %v", got)
+	}
+}
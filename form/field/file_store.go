@@ -0,0 +1,197 @@
+package field
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ============================================================================
+// Uploaded Files and Storage
+// ============================================================================
+
+// UploadedFile is the metadata of a file received by a FileField.
+type UploadedFile struct {
+	Key         string `json:"key"`         // Storage key (see FileStore)
+	Name        string `json:"name"`        // Original file name (base name only, as sent by the client)
+	Size        int64  `json:"size"`        // Size in bytes
+	ContentType string `json:"contentType"` // MIME type detected from the content
+}
+
+// Extension returns the lower case file extension of the original name, including the dot.
+func (u UploadedFile) Extension() string {
+	return strings.ToLower(filepath.Ext(u.Name))
+}
+
+// FileValue is the value of a FileField: the received files in upload order.
+type FileValue []UploadedFile
+
+// First returns the first file, or nil if there is none (for single file fields).
+func (v FileValue) First() *UploadedFile {
+	if len(v) == 0 {
+		return nil
+	}
+	return &v[0]
+}
+
+// FileStore stores uploaded file content.
+// Implementations must be safe for concurrent use.
+type FileStore interface {
+	// Save stores the content read from r and returns the storage key.
+	// If reading r fails, no partial content may be kept and the error is returned as is.
+	Save(file UploadedFile, r io.Reader) (string, error)
+
+	// Open returns the content stored under key.
+	Open(key string) (io.ReadCloser, error)
+
+	// Delete removes the content stored under key.
+	Delete(key string) error
+}
+
+// newStorageKey returns a random storage key keeping the file extension.
+func newStorageKey(file UploadedFile) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generating storage key: %w", err)
+	}
+	return hex.EncodeToString(b) + file.Extension(), nil
+}
+
+// LocalFileStore stores files in a directory on the local disk under random names.
+type LocalFileStore struct {
+	Dir string
+}
+
+// NewLocalFileStore creates a file store in dir. The directory is created on first use.
+//
+// Example:
+//
+//	store := field.NewLocalFileStore("/var/lib/app/uploads")
+//	logo := field.NewFileField("logo", "LOGO", false, 2<<20).SetStore(store)
+func NewLocalFileStore(dir string) *LocalFileStore {
+	return &LocalFileStore{Dir: dir}
+}
+
+// Save writes the content to a temporary file and renames it once complete.
+func (s *LocalFileStore) Save(file UploadedFile, r io.Reader) (string, error) {
+	key, err := newStorageKey(file)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(s.Dir, 0o750); err != nil {
+		return "", fmt.Errorf("creating upload directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(s.Dir, ".upload-*")
+	if err != nil {
+		return "", fmt.Errorf("creating upload file: %w", err)
+	}
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("writing upload file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), filepath.Join(s.Dir, key)); err != nil {
+		os.Remove(tmp.Name())
+		return "", fmt.Errorf("storing upload file: %w", err)
+	}
+	return key, nil
+}
+
+// Open opens the stored file.
+func (s *LocalFileStore) Open(key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	return os.Open(path)
+}
+
+// Delete removes the stored file.
+func (s *LocalFileStore) Delete(key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// path returns the file path for key, rejecting keys that leave the directory.
+func (s *LocalFileStore) path(key string) (string, error) {
+	if key == "" || key != filepath.Base(key) || strings.HasPrefix(key, ".") {
+		return "", fmt.Errorf("invalid storage key %q", key)
+	}
+	return filepath.Join(s.Dir, key), nil
+}
+
+// MemoryFileStore keeps files in memory, e.g. for tests or small temporary uploads.
+type MemoryFileStore struct {
+	mu    sync.RWMutex
+	files map[string][]byte
+}
+
+// NewMemoryFileStore creates an empty in-memory file store.
+func NewMemoryFileStore() *MemoryFileStore {
+	return &MemoryFileStore{files: make(map[string][]byte)}
+}
+
+// Save reads the content into memory.
+func (s *MemoryFileStore) Save(file UploadedFile, r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	key, err := newStorageKey(file)
+	if err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	s.files[key] = data
+	s.mu.Unlock()
+	return key, nil
+}
+
+// Open returns a reader for the stored content.
+func (s *MemoryFileStore) Open(key string) (io.ReadCloser, error) {
+	data, ok := s.Get(key)
+	if !ok {
+		return nil, fmt.Errorf("file %q: %w", key, os.ErrNotExist)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Delete removes the stored content.
+func (s *MemoryFileStore) Delete(key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.files[key]; !ok {
+		return fmt.Errorf("file %q: %w", key, os.ErrNotExist)
+	}
+	delete(s.files, key)
+	return nil
+}
+
+// Get returns the stored content.
+func (s *MemoryFileStore) Get(key string) ([]byte, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.files[key]
+	return data, ok
+}
+
+// Len returns the number of stored files.
+func (s *MemoryFileStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.files)
+}
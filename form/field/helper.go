@@ -13,24 +13,18 @@ import (
 
 // parseDateTime parses a date/time from various formats:
 // - Unix timestamp (int, int64, float64)
-// - ISO date string ("2006-01-02")
+// - time.Time
+// - ISO date string ("2006-01-02") or datetime without offset, as wall clock time in loc
 // - ISO datetime string ("2006-01-02T15:04:05Z")
-func parseDateTime(raw interface{}) (time.Time, error) {
+func parseDateTime(raw interface{}, loc *time.Location) (time.Time, error) {
 	if raw == nil {
 		return time.Time{}, fmt.Errorf("date value is nil")
 	}
 
 	switch v := raw.(type) {
 	case string:
-		// Try ISO date format first (2006-01-02)
-		if t, err := time.Parse("2006-01-02", v); err == nil {
-			return t, nil
-		}
-		// Try ISO datetime format (2006-01-02T15:04:05Z)
-		if t, err := time.Parse(time.RFC3339, v); err == nil {
-			return t, nil
-		}
-		return time.Time{}, fmt.Errorf("invalid date string format: %s", v)
+		// ISO date, datetime with or without offset (see parseTimeString)
+		return parseTimeString(v, "", loc)
 
 	case int:
 		// Unix timestamp (seconds)
@@ -48,6 +42,9 @@ func parseDateTime(raw interface{}) (time.Time, error) {
 		// Unix timestamp (seconds, from JSON number)
 		return time.Unix(int64(v), 0), nil
 
+	case time.Time:
+		return v, nil
+
 	default:
 		return time.Time{}, fmt.Errorf("unsupported date type: %T", v)
 	}
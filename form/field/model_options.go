@@ -0,0 +1,158 @@
+package field
+
+import (
+	"fmt"
+
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// ============================================================================
+// Remote Model Options
+// ============================================================================
+//
+// By default ModelField and ModelListField load all options once (LoaderFunc)
+// and embed them in the exported form. For large sets (thousands of devices or
+// drivers) the fields can use remote options instead (SetRemoteOptions): the
+// form only contains the selected options and a URL, the frontend searches
+// page by page through that URL (see echoadapter.ModelOptions), and submitted IDs
+// are resolved through the PagedModelLoader on bind.
+
+// DefaultModelOptionsPageSize is the page size of remote option searches if none is requested.
+const DefaultModelOptionsPageSize = 50
+
+// MaxModelOptionsPageSize is the largest page size of remote option searches.
+const MaxModelOptionsPageSize = 200
+
+// ModelOptionsQuery is a search for one page of model options.
+type ModelOptionsQuery struct {
+	ModelType string                 // Type of model (e.g., "device", "driver")
+	Search    string                 // Search term entered by the user (empty = all)
+	Page      int                    // Page number, starting at 1
+	PageSize  int                    // Options per page
+	Filter    map[string]interface{} // Additional filter parameters of the field
+}
+
+// Offset returns the number of options before the requested page.
+func (q ModelOptionsQuery) Offset() int {
+	return (q.Page - 1) * q.PageSize
+}
+
+// ModelOptionsPage is one page of model options.
+type ModelOptionsPage struct {
+	Options []ModelOption // Options of the page, in a stable order
+	Total   int           // Total number of matching options (-1 = unknown)
+	HasMore bool          // Whether there are options after this page
+}
+
+// PagedModelLoader loads model options page by page.
+// The implementation is project-specific (e.g., database-backed) and must only
+// return options the user of ctx may select.
+type PagedModelLoader interface {
+	// SearchOptions returns one page of options matching the query.
+	SearchOptions(ctx *uicontext.UiContext, query ModelOptionsQuery) (ModelOptionsPage, error)
+
+	// ResolveOptions returns the options with the given IDs.
+	// IDs that do not exist or are not accessible are left out.
+	ResolveOptions(ctx *uicontext.UiContext, modelType string, ids []int32) ([]ModelOption, error)
+}
+
+// RemoteOptionsField is a field with remote options (ModelField, ModelListField).
+type RemoteOptionsField interface {
+	FormField
+
+	// HasRemoteOptions returns true if the field uses a PagedModelLoader.
+	HasRemoteOptions() bool
+
+	// SearchOptions returns one page of the field's options for a search term.
+	SearchOptions(ctx *uicontext.UiContext, search string, page, pageSize int) (ModelOptionsPage, error)
+
+	// ResolveOptions returns the field's options with the given IDs.
+	ResolveOptions(ctx *uicontext.UiContext, ids []int32) ([]ModelOption, error)
+}
+
+// searchRemoteOptions returns one page of options, without the excluded (Sub) IDs.
+func searchRemoteOptions(loader PagedModelLoader, ctx *uicontext.UiContext, query ModelOptionsQuery, sub []int32) (ModelOptionsPage, error) {
+	if loader == nil {
+		return ModelOptionsPage{}, fmt.Errorf("no paged loader for model type %s", query.ModelType)
+	}
+	if query.Page < 1 {
+		query.Page = 1
+	}
+	if query.PageSize < 1 {
+		query.PageSize = DefaultModelOptionsPageSize
+	}
+	if query.PageSize > MaxModelOptionsPageSize {
+		query.PageSize = MaxModelOptionsPageSize
+	}
+
+	page, err := loader.SearchOptions(ctx, query)
+	if err != nil {
+		return ModelOptionsPage{}, err
+	}
+	page.Options = withoutIDs(page.Options, sub)
+	return page, nil
+}
+
+// resolveRemoteOptions returns the options with the given IDs, without the excluded (Sub) IDs.
+func resolveRemoteOptions(loader PagedModelLoader, ctx *uicontext.UiContext, modelType string, ids []int32, sub []int32) ([]ModelOption, error) {
+	if loader == nil {
+		return nil, fmt.Errorf("no paged loader for model type %s", modelType)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	options, err := loader.ResolveOptions(ctx, modelType, ids)
+	if err != nil {
+		return nil, err
+	}
+	return withoutIDs(options, sub), nil
+}
+
+// checkRemoteOptions resolves submitted IDs through the loader and returns an
+// invalid option error for the first ID it does not return. More than
+// MaxModelOptionsPageSize IDs are rejected without calling the loader.
+func checkRemoteOptions(loader PagedModelLoader, ctx *uicontext.UiContext, fieldID, modelType string, ids []int32, sub []int32) error {
+	if len(ids) > MaxModelOptionsPageSize {
+		return NewFieldError(fieldID, ErrCodeMaxItems, map[string]interface{}{"max": MaxModelOptionsPageSize},
+			"field %s must have at most %d items", fieldID, MaxModelOptionsPageSize)
+	}
+	options, err := resolveRemoteOptions(loader, ctx, modelType, ids, sub)
+	if err != nil {
+		return fmt.Errorf("resolving options for field %s: %w", fieldID, err)
+	}
+	found := make(map[int32]bool, len(options))
+	for _, opt := range options {
+		found[opt.ID] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return NewFieldError(fieldID, ErrCodeInvalidOption, map[string]interface{}{"value": id},
+				"invalid option %d for field %s", id, fieldID)
+		}
+	}
+	return nil
+}
+
+// withoutIDs returns the options without the given IDs.
+func withoutIDs(options []ModelOption, ids []int32) []ModelOption {
+	if len(ids) == 0 {
+		return options
+	}
+	result := make([]ModelOption, 0, len(options))
+	for _, opt := range options {
+		if !containsID(ids, opt.ID) {
+			result = append(result, opt)
+		}
+	}
+	return result
+}
+
+// containsID returns true if ids contains id.
+func containsID(ids []int32, id int32) bool {
+	for _, candidate := range ids {
+		if candidate == id {
+			return true
+		}
+	}
+	return false
+}
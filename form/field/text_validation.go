@@ -0,0 +1,179 @@
+package field
+
+import (
+	"net/mail"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ============================================================================
+// TextField Format Validation (Pattern and Subtypes)
+// ============================================================================
+
+// Error codes for text format validation
+const (
+	ErrCodeEmail    ErrorCode = "email"    // Not a valid email address
+	ErrCodeURL      ErrorCode = "url"      // Not a valid URL (params: schemes)
+	ErrCodeTel      ErrorCode = "tel"      // Not a valid E.164 phone number (national numbers need TextField.PhoneCountry)
+	ErrCodePassword ErrorCode = "password" // Password too weak (params: rule, and min for rule "minLength")
+)
+
+// DefaultURLSchemes are the schemes accepted for the "url" subtype when URLSchemes is not set.
+var DefaultURLSchemes = []string{"http", "https"}
+
+// PasswordPolicy defines the strength rules for the "password" subtype.
+// A nil policy on the field means no strength checks (only length limits apply).
+type PasswordPolicy struct {
+	MinLength     int  // Minimum number of characters (runes)
+	RequireUpper  bool // At least one uppercase letter
+	RequireLower  bool // At least one lowercase letter
+	RequireDigit  bool // At least one digit
+	RequireSymbol bool // At least one character that is not a letter or digit
+}
+
+// DefaultPasswordPolicy is a reasonable policy for user passwords.
+var DefaultPasswordPolicy = PasswordPolicy{
+	MinLength:    8,
+	RequireUpper: true,
+	RequireLower: true,
+	RequireDigit: true,
+}
+
+// patternCache caches compiled Pattern regexes across requests (pattern -> *regexp.Regexp).
+var patternCache sync.Map
+
+// e164 matches a normalized E.164 phone number: + followed by up to 15 digits.
+var e164 = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// compilePattern returns the compiled regex for pattern, compiling it once.
+// The pattern must match the whole value (it is anchored automatically).
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	if re, ok := patternCache.Load(pattern); ok {
+		return re.(*regexp.Regexp), nil
+	}
+	re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		return nil, err
+	}
+	patternCache.Store(pattern, re)
+	return re, nil
+}
+
+// NormalizePhone converts a phone number into E.164 format by removing spaces,
+// dashes, dots and parentheses and replacing a leading "00" with "+".
+// The result is not validated; use with the "tel" subtype check.
+//
+// Example:
+//
+//	NormalizePhone("0043 (664) 123-45-67") // "+436641234567"
+func NormalizePhone(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')', '/', '\t':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(s))
+	if strings.HasPrefix(s, "00") {
+		s = "+" + s[2:]
+	}
+	return s
+}
+
+// normalizePhoneCountry normalizes a phone number like NormalizePhone and converts
+// a national number with a leading 0 into E.164 with the country calling code.
+func normalizePhoneCountry(s, country string) string {
+	s = NormalizePhone(s)
+	if country != "" && strings.HasPrefix(s, "0") {
+		s = "+" + strings.TrimPrefix(country, "+") + s[1:]
+	}
+	return s
+}
+
+// validateFormat checks Pattern and the subtype-specific format of a non-empty value.
+// Patterns that are not valid Go regexp syntax are left to the frontend (see PatternError).
+func (f *TextField) validateFormat(str string) error {
+	if f.Pattern != "" {
+		if re, err := compilePattern(f.Pattern); err == nil && !re.MatchString(str) {
+			return NewFieldError(f.ID, ErrCodePattern, map[string]interface{}{"pattern": f.Pattern},
+				"text field %s does not match the required pattern", f.ID)
+		}
+	}
+
+	switch f.Subtype {
+	case "email":
+		addr, err := mail.ParseAddress(str)
+		// Reject display names ("Name <a@b.c>") and hosts without a dot
+		if err != nil || addr.Address != str || !strings.Contains(str[strings.LastIndex(str, "@"):], ".") {
+			return NewFieldError(f.ID, ErrCodeEmail, nil, "text field %s must be a valid email address", f.ID)
+		}
+
+	case "url":
+		schemes := f.URLSchemes
+		if len(schemes) == 0 {
+			schemes = DefaultURLSchemes
+		}
+		u, err := url.Parse(str)
+		if err != nil || u.Host == "" || !slices.Contains(schemes, strings.ToLower(u.Scheme)) {
+			return NewFieldError(f.ID, ErrCodeURL, map[string]interface{}{"schemes": strings.Join(schemes, ", ")},
+				"text field %s must be a valid URL (%s)", f.ID, strings.Join(schemes, ", "))
+		}
+
+	case "tel":
+		if !e164.MatchString(str) {
+			return NewFieldError(f.ID, ErrCodeTel, nil, "text field %s must be a valid phone number", f.ID)
+		}
+
+	case "password":
+		if f.PasswordPolicy != nil {
+			return f.PasswordPolicy.validate(f.ID, str)
+		}
+	}
+
+	return nil
+}
+
+// validate checks str against the policy and returns the first failing rule.
+func (p *PasswordPolicy) validate(fieldID, str string) error {
+	if p.MinLength > 0 && utf8.RuneCountInString(str) < p.MinLength {
+		return NewFieldError(fieldID, ErrCodePassword, map[string]interface{}{"rule": "minLength", "min": p.MinLength},
+			"password %s must be at least %d characters", fieldID, p.MinLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range str {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r):
+			symbol = true
+		}
+	}
+
+	rules := []struct {
+		required bool
+		ok       bool
+		rule     string
+	}{
+		{p.RequireUpper, upper, "upper"},
+		{p.RequireLower, lower, "lower"},
+		{p.RequireDigit, digit, "digit"},
+		{p.RequireSymbol, symbol, "symbol"},
+	}
+	for _, r := range rules {
+		if r.required && !r.ok {
+			return NewFieldError(fieldID, ErrCodePassword, map[string]interface{}{"rule": r.rule},
+				"password %s must contain at least one %s character", fieldID, r.rule)
+		}
+	}
+	return nil
+}
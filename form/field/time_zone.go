@@ -0,0 +1,156 @@
+package field
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// ============================================================================
+// Timezone Handling for Date/Time Fields
+// ============================================================================
+//
+// Date and datetime strings without offset ("2026-03-01", "2026-03-01T08:30")
+// are wall clock times in the user's timezone (UiContext.Timezone). Fields
+// receive the zone through BindContext when the FormGroup context is set; an
+// explicit SetLocation takes precedence for parsing and export.
+//
+// DST handling for wall clock times (see LocalTime):
+//   - gap (e.g. 02:30 when clocks jump from 02:00 to 03:00): moved forward by the gap length (03:30)
+//   - overlap (e.g. 02:30 when clocks go back from 03:00 to 02:00): the earlier instant (summer time)
+//
+// Fields with DateOnly store pure calendar dates as midnight UTC, independent
+// of the user's zone, and export them as midnight in the user's zone.
+
+// localLayouts are the accepted date/time layouts without offset, in parse order.
+var localLayouts = []string{
+	"2006-01-02",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// ContextLocation returns the location of the user's timezone (nil if ctx is nil
+// or the zone cannot be loaded).
+func ContextLocation(ctx *uicontext.UiContext) *time.Location {
+	if ctx == nil {
+		return nil
+	}
+	loc, err := time.LoadLocation(ctx.Timezone.GetIANA())
+	if err != nil {
+		return nil
+	}
+	return loc
+}
+
+// fieldLocation returns the timezone used for parsing: the explicit field location,
+// falling back to the location bound by BindContext.
+func fieldLocation(explicit, bound *time.Location) *time.Location {
+	if explicit != nil {
+		return explicit
+	}
+	return bound
+}
+
+// exportLocation returns the timezone used for export, in the same precedence as
+// parsing: the explicit field location, then the export context, then the location
+// bound by BindContext.
+func exportLocation(ctx *uicontext.UiContext, explicit, bound *time.Location) *time.Location {
+	if explicit != nil {
+		return explicit
+	}
+	if loc := ContextLocation(ctx); loc != nil {
+		return loc
+	}
+	return bound
+}
+
+// locationOrUTC returns loc, or UTC if loc is nil.
+func locationOrUTC(loc *time.Location) *time.Location {
+	if loc == nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// LocalTime returns the instant of a wall clock time in loc, resolving DST gaps
+// (moved forward by the gap length) and overlaps (earlier instant) deterministically.
+//
+// Example:
+//
+//	vienna, _ := time.LoadLocation("Europe/Vienna")
+//	field.LocalTime(2026, time.March, 29, 2, 30, 0, vienna) // 03:30 CEST
+func LocalTime(year int, month time.Month, day, hour, min, sec int, loc *time.Location) time.Time {
+	loc = locationOrUTC(loc)
+	wall := time.Date(year, month, day, hour, min, sec, 0, time.UTC)
+
+	// Offsets in effect around the wall time (before and after a possible transition)
+	_, offsetBefore := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, offsetAfter := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	var result time.Time
+	for _, offset := range []int{offsetBefore, offsetAfter} {
+		candidate := wall.Add(-time.Duration(offset) * time.Second).In(loc)
+		if sameWallClock(candidate, wall) && (result.IsZero() || candidate.Before(result)) {
+			result = candidate
+		}
+	}
+	if result.IsZero() {
+		// Gap: apply the offset before the transition, which moves the time forward
+		result = wall.Add(-time.Duration(offsetBefore) * time.Second).In(loc)
+	}
+	return result
+}
+
+// sameWallClock returns true if t shows the wall clock time of wall (a UTC time).
+func sameWallClock(t, wall time.Time) bool {
+	y, m, d := t.Date()
+	wy, wm, wd := wall.Date()
+	return y == wy && m == wm && d == wd &&
+		t.Hour() == wall.Hour() && t.Minute() == wall.Minute() && t.Second() == wall.Second()
+}
+
+// parseTimeString parses a date/time string. RFC 3339 strings keep their offset;
+// strings without offset (including the custom format) are wall clock times in loc.
+func parseTimeString(v, format string, loc *time.Location) (time.Time, error) {
+	layouts := localLayouts
+	if format != "" {
+		layouts = append(layouts[:len(layouts):len(layouts)], format)
+	}
+
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	for _, layout := range layouts {
+		t, err := time.Parse(layout, v)
+		if err != nil {
+			continue
+		}
+		if t.Location() != time.UTC {
+			// Custom format with zone information
+			return t, nil
+		}
+		return LocalTime(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), loc), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date string format: %s", v)
+}
+
+// calendarDate returns the calendar date of t in loc as midnight UTC.
+func calendarDate(t time.Time, loc *time.Location) time.Time {
+	y, m, d := t.In(locationOrUTC(loc)).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// localMidnight returns midnight in loc for the calendar date stored as midnight UTC.
+func localMidnight(date time.Time, loc *time.Location) time.Time {
+	y, m, d := date.UTC().Date()
+	return LocalTime(y, m, d, 0, 0, 0, loc)
+}
+
+// dayOffset returns midnight in loc, days from today.
+func dayOffset(days int64, loc *time.Location) int64 {
+	now := time.Now().In(locationOrUTC(loc))
+	return LocalTime(now.Year(), now.Month(), now.Day()+int(days), 0, 0, 0, loc).Unix()
+}
@@ -0,0 +1,174 @@
+package group
+
+import (
+	"reflect"
+
+	"github.com/xiriframework/xiri-go/form/field"
+)
+
+// ============================================================================
+// Server-Side Field Conditions (ShowWhen, DisabledWhen, RequiredWhen)
+// ============================================================================
+//
+// The frontend hides fields whose ShowWhen conditions are false and disables
+// fields whose DisabledWhen condition is true. The server applies the same
+// conditions: a hidden or disabled field is neither required nor validated
+// nor bound, and values sent for it are ignored. A field whose RequiredWhen
+// condition is true is required.
+//
+// Values of ignored (hidden or disabled) fields count as absent when evaluating
+// the conditions of other fields, so a field depending on an ignored field is
+// evaluated as if the controlling field had no value.
+
+// conditionalField is implemented by fields with conditions (BaseField).
+type conditionalField interface {
+	GetShowWhenExpr() field.ConditionExpr
+	GetDisabledWhen() field.ConditionExpr
+	GetRequiredWhen() field.ConditionExpr
+}
+
+// EvaluateConditions evaluates the conditions of the active fields against the
+// given values and returns the IDs of ignored fields (hidden or disabled).
+// The result is kept in the group for IsFieldHidden, IsFieldDisabled,
+// IsFieldIgnored and IsFieldRequired (e.g. for BindInto).
+//
+// Example:
+//
+//	ignored := fg.EvaluateConditions(parsed)
+//	if ignored["serial"] { ... }
+func (fg *FormGroup) EvaluateConditions(values map[string]interface{}) map[string]bool {
+	const (
+		pending = iota + 1
+		active
+		hidden
+		disabled
+	)
+	state := make(map[string]int, len(fg.fields))
+
+	var resolve func(id string) bool
+	var holds func(expr field.ConditionExpr) bool
+
+	// resolve returns true if the field is neither hidden nor disabled
+	resolve = func(id string) bool {
+		switch state[id] {
+		case active, pending: // pending = cyclic dependency, evaluate the value as is
+			return true
+		case hidden, disabled:
+			return false
+		}
+
+		f, ok := fg.index[id]
+		if !ok {
+			return true
+		}
+		cf, ok := f.(conditionalField)
+		if !ok {
+			state[id] = active
+			return true
+		}
+
+		state[id] = pending
+		result := active
+		if expr := cf.GetShowWhenExpr(); expr != nil && !holds(expr) {
+			result = hidden
+		} else if expr := cf.GetDisabledWhen(); expr != nil && holds(expr) {
+			result = disabled
+		}
+		state[id] = result
+		return result == active
+	}
+
+	// holds evaluates an expression with ignored controlling fields removed from the values
+	holds = func(expr field.ConditionExpr) bool {
+		var copied map[string]interface{}
+		for _, dep := range expr.Fields() {
+			if resolve(dep) {
+				continue
+			}
+			if copied == nil {
+				copied = make(map[string]interface{}, len(values))
+				for k, v := range values {
+					copied[k] = v
+				}
+			}
+			delete(copied, dep)
+		}
+		if copied == nil {
+			return expr.Evaluate(values)
+		}
+		return expr.Evaluate(copied)
+	}
+
+	fg.hidden = make(map[string]bool)
+	fg.disabled = make(map[string]bool)
+	fg.required = make(map[string]bool)
+	ignored := make(map[string]bool)
+	for _, f := range fg.fields {
+		id := f.GetID()
+		resolve(id)
+		switch state[id] {
+		case hidden:
+			fg.hidden[id] = true
+			ignored[id] = true
+		case disabled:
+			fg.disabled[id] = true
+			ignored[id] = true
+		default:
+			if cf, ok := f.(conditionalField); ok {
+				if expr := cf.GetRequiredWhen(); expr != nil && holds(expr) {
+					fg.required[id] = true
+				}
+			}
+		}
+	}
+	return ignored
+}
+
+// IsFieldHidden returns true if the field was hidden by its ShowWhen conditions
+// in the last evaluation (ParseValues, ValidateValues or EvaluateConditions).
+func (fg *FormGroup) IsFieldHidden(id string) bool {
+	return fg.hidden[id]
+}
+
+// IsFieldDisabled returns true if the field was disabled by its DisabledWhen
+// condition in the last evaluation.
+func (fg *FormGroup) IsFieldDisabled(id string) bool {
+	return fg.disabled[id]
+}
+
+// IsFieldIgnored returns true if the field was hidden or disabled in the last
+// evaluation, so its value is neither required nor bound.
+func (fg *FormGroup) IsFieldIgnored(id string) bool {
+	return fg.hidden[id] || fg.disabled[id]
+}
+
+// IsFieldRequired returns true if the field is required, statically or by its
+// RequiredWhen condition in the last evaluation.
+func (fg *FormGroup) IsFieldRequired(f field.FormField) bool {
+	return f.IsRequired() || fg.required[f.GetID()]
+}
+
+// RequiredWhenError returns a required error if the field is required by its
+// RequiredWhen condition and value is empty (nil, "" or an empty list), else nil.
+// Statically required fields are checked by their own Validate.
+func (fg *FormGroup) RequiredWhenError(id string, value interface{}) *field.FieldError {
+	if !fg.required[id] {
+		return nil
+	}
+	if !isMissingValue(value) {
+		return nil
+	}
+	return field.NewFieldError(id, field.ErrCodeRequired, nil, "field %s is required", id)
+}
+
+// isMissingValue returns true for nil, "" and empty lists.
+func isMissingValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	}
+	rv := reflect.ValueOf(value)
+	return (rv.Kind() == reflect.Slice || rv.Kind() == reflect.Map) && rv.Len() == 0
+}
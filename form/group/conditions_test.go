@@ -0,0 +1,119 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/xiriframework/xiri-go/form/field"
+)
+
+func visibilityGroup() *FormGroup {
+	serial := field.NewTextField("serial", "SERIAL", true, "")
+	serial.SetShowWhen("kind", field.CondEquals, 2)
+	note := field.NewTextField("note", "NOTE", true, "")
+	note.SetShowWhenNotEmpty("serial")
+
+	return NewFormGroup([]field.FormField{
+		field.NewIntField("kind", "KIND", true, 0),
+		serial,
+		note,
+	})
+}
+
+func TestVisibility_HiddenFieldsNotRequired(t *testing.T) {
+	fg := visibilityGroup()
+
+	values, err := fg.ParseAndValidate(map[string]interface{}{"kind": float64(1), "serial": "SN-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := values["serial"]; ok {
+		t.Error("expected value of hidden field to be ignored")
+	}
+	if !fg.IsFieldHidden("serial") || !fg.IsFieldHidden("note") {
+		t.Error("expected serial and dependent note to be hidden")
+	}
+}
+
+func TestVisibility_VisibleFieldsRequired(t *testing.T) {
+	fg := visibilityGroup()
+
+	_, err := fg.ParseAndValidate(map[string]interface{}{"kind": float64(2)})
+	verrs, _ := AsValidationErrors(err)
+	if len(verrs) != 1 || verrs[0].Field != "serial" || verrs[0].Code != field.ErrCodeRequired {
+		t.Errorf("expected required error on serial only, got %v", err)
+	}
+
+	_, err = fg.ParseAndValidate(map[string]interface{}{"kind": float64(2), "serial": "SN-1"})
+	verrs, _ = AsValidationErrors(err)
+	if len(verrs) != 1 || verrs[0].Field != "note" {
+		t.Errorf("expected required error on note, got %v", err)
+	}
+}
+
+func TestVisibility_RulesSkipHiddenFields(t *testing.T) {
+	fg := visibilityGroup().AddRule(FieldsMatch("note", "serial"))
+
+	if _, err := fg.ParseAndValidate(map[string]interface{}{"kind": float64(1)}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestConditions_DisabledAndRequiredWhen(t *testing.T) {
+	status := field.NewIntField("status", "STATUS", true, 0)
+	reason := field.NewTextField("reason", "REASON", false, "")
+	reason.SetRequiredWhen(field.Or(
+		field.NewCondition("status", field.CondEquals, 3),
+		field.NewCondition("status", field.CondEquals, 4),
+	))
+	price := field.NewIntField("price", "PRICE", true, 0)
+	price.SetDisabledWhen(field.Not(field.NewCondition("status", field.CondLess, 2)))
+
+	fg := NewFormGroup([]field.FormField{status, reason, price})
+
+	// status 3: reason required, price disabled (not required, value ignored)
+	_, err := fg.ParseAndValidate(map[string]interface{}{"status": float64(3), "price": float64(9)})
+	verrs, _ := AsValidationErrors(err)
+	if len(verrs) != 1 || verrs[0].Field != "reason" || verrs[0].Code != field.ErrCodeRequired {
+		t.Fatalf("expected required error on reason, got %v", err)
+	}
+
+	_, err = fg.ParseAndValidate(map[string]interface{}{"status": float64(3), "reason": "", "price": float64(9)})
+	if verrs, _ := AsValidationErrors(err); len(verrs) != 1 || verrs[0].Field != "reason" {
+		t.Fatalf("expected required error for empty reason, got %v", err)
+	}
+
+	values, err := fg.ParseAndValidate(map[string]interface{}{"status": float64(3), "reason": "broken", "price": float64(9)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := values["price"]; ok || !fg.IsFieldDisabled("price") {
+		t.Error("expected value of disabled field to be ignored")
+	}
+
+	// status 1: reason optional, price enabled and required
+	_, err = fg.ParseAndValidate(map[string]interface{}{"status": float64(1)})
+	if verrs, _ := AsValidationErrors(err); len(verrs) != 1 || verrs[0].Field != "price" {
+		t.Errorf("expected required error on price only, got %v", err)
+	}
+}
+
+func TestConditions_ShowWhenExpr(t *testing.T) {
+	serial := field.NewTextField("serial", "SERIAL", true, "")
+	serial.SetShowWhenExpr(field.And(
+		field.NewCondition("type", field.CondIn, []int{2, 3}),
+		field.Not(field.NewCondition("archived", field.CondEquals, true)),
+	))
+	fg := NewFormGroup([]field.FormField{
+		field.NewIntField("type", "TYPE", true, 0),
+		field.NewBoolField("archived", "ARCHIVED", false, false),
+		serial,
+	})
+
+	if _, err := fg.ParseAndValidate(map[string]interface{}{"type": float64(2), "archived": true}); err != nil {
+		t.Errorf("unexpected error for hidden serial: %v", err)
+	}
+	_, err := fg.ParseAndValidate(map[string]interface{}{"type": float64(2), "archived": false})
+	if verrs, _ := AsValidationErrors(err); len(verrs) != 1 || verrs[0].Field != "serial" {
+		t.Errorf("expected required error on serial, got %v", err)
+	}
+}
@@ -0,0 +1,102 @@
+package group
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/xiriframework/xiri-go/form/field"
+)
+
+// LoadDependentOptions loads the options of dependent fields (see field.DependentOptionsField)
+// for the parent values in values; a parent without value loads the options for nil.
+// Conditions are evaluated first: hidden or disabled parents count as without value,
+// and hidden or disabled children are skipped. Options are loaded again only when the
+// parent value changed since the last load, so repeated validations do not call the
+// loaders again. Parents are loaded before their children, and the value of each
+// loaded field is parsed again against its new options (updated in values), so chains
+// like country -> region -> city see option values. ValidateValues and
+// builder.BindFromMap call it before validating, so child values are checked against
+// the options of the submitted parent value.
+func (fg *FormGroup) LoadDependentOptions(values map[string]interface{}) error {
+	ignored := fg.EvaluateConditions(values)
+	if fg.dependentParents == nil {
+		fg.dependentParents = make(map[string]interface{})
+	}
+	loaded := make(map[string]bool)
+
+	var load func(f field.FormField) error
+	load = func(f field.FormField) error {
+		dependent, ok := f.(field.DependentOptionsField)
+		if !ok || dependent.GetDependsOn() == "" || loaded[f.GetID()] || ignored[f.GetID()] {
+			return nil
+		}
+		loaded[f.GetID()] = true // also guards against cyclic dependencies
+
+		parentID := dependent.GetDependsOn()
+		if parent, ok := fg.index[parentID]; ok {
+			if err := load(parent); err != nil {
+				return err
+			}
+		}
+		var parentValue interface{}
+		if !ignored[parentID] {
+			parentValue = values[parentID]
+		}
+		if last, ok := fg.dependentParents[f.GetID()]; !ok || !reflect.DeepEqual(last, parentValue) {
+			if err := dependent.LoadDependentOptions(fg.ctx, parentValue); err != nil {
+				return err
+			}
+			fg.dependentParents[f.GetID()] = parentValue
+		}
+		if value, exists := values[f.GetID()]; exists && value != nil {
+			if reparsed, err := f.Parse(value); err == nil {
+				values[f.GetID()] = reparsed
+			}
+		}
+		return nil
+	}
+
+	for _, f := range fg.fields {
+		if err := load(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshDependentOptions loads the options of a dependent field for a new parent value
+// and returns them as list of {id, name}. The raw parent value (e.g. a query parameter)
+// is parsed by the parent field; an empty string counts as no value.
+//
+// Example:
+//
+//	list, err := fg.RefreshDependentOptions("region", c.QueryParam("parent"))
+func (fg *FormGroup) RefreshDependentOptions(fieldID string, rawParent interface{}) ([]map[string]interface{}, error) {
+	f, ok := fg.index[fieldID]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %s", fieldID)
+	}
+	dependent, ok := f.(field.DependentOptionsField)
+	if !ok || dependent.GetDependsOn() == "" {
+		return nil, fmt.Errorf("field %s has no dependent options", fieldID)
+	}
+
+	var parent interface{}
+	if rawParent != nil && rawParent != "" {
+		parentField, ok := fg.index[dependent.GetDependsOn()]
+		if !ok {
+			return nil, fmt.Errorf("unknown parent field %s of field %s", dependent.GetDependsOn(), fieldID)
+		}
+		value, err := parentField.Parse(rawParent)
+		if err != nil {
+			return nil, fmt.Errorf("parsing parent value of field %s: %w", fieldID, err)
+		}
+		parent = value
+	}
+
+	if err := dependent.LoadDependentOptions(fg.ctx, parent); err != nil {
+		return nil, err
+	}
+	delete(fg.dependentParents, fieldID) // loaded for another parent than the form values
+	return dependent.ExportOptions(), nil
+}
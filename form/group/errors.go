@@ -0,0 +1,122 @@
+package group
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/response"
+)
+
+// ValidationErrors collects the validation errors of all failing fields of a form.
+// ParseValues, ValidateValues, ParseAndValidate and builder.BindFromMap return it
+// as error, so existing callers keep working; use AsValidationErrors to get the details.
+//
+// Example:
+//
+//	values, err := fg.ParseAndValidate(raw)
+//	if verrs, ok := group.AsValidationErrors(err); ok {
+//	    return c.JSON(http.StatusUnprocessableEntity, verrs.ToResponse(fg))
+//	}
+type ValidationErrors []*field.FieldError
+
+// Error joins the English messages of all field errors.
+func (v ValidationErrors) Error() string {
+	messages := make([]string, len(v))
+	for i, e := range v {
+		messages[i] = e.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Has returns true if there is at least one error for the given field
+// (including errors of its nested fields, e.g. "contacts.0.phone" for "contacts").
+func (v ValidationErrors) Has(fieldID string) bool {
+	for _, e := range v {
+		if errorBelongsTo(e, fieldID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add appends err for the given field.
+// Nested ValidationErrors (e.g. rows of a RepeaterField) are added as they are;
+// errors that are not field errors (e.g. parse errors) are added with code ErrCodeInvalid.
+func (v *ValidationErrors) Add(fieldID string, err error) {
+	var nested ValidationErrors
+	if errors.As(err, &nested) {
+		*v = append(*v, nested...)
+		return
+	}
+	var fe *field.FieldError
+	if errors.As(err, &fe) {
+		if fe.Field == "" {
+			fe.Field = fieldID
+		}
+		*v = append(*v, fe)
+		return
+	}
+	*v = append(*v, &field.FieldError{Field: fieldID, Code: field.ErrCodeInvalid, Message: err.Error()})
+}
+
+// errorBelongsTo returns true if e is an error of the field or one of its nested fields.
+func errorBelongsTo(e *field.FieldError, fieldID string) bool {
+	return e.Field == fieldID || strings.HasPrefix(e.Field, fieldID+".")
+}
+
+// OrNil returns nil if there are no errors, so the result can be returned as error directly.
+func (v ValidationErrors) OrNil() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
+
+// AsValidationErrors extracts ValidationErrors from err (also when wrapped).
+func AsValidationErrors(err error) (ValidationErrors, bool) {
+	var verrs ValidationErrors
+	if errors.As(err, &verrs) {
+		return verrs, true
+	}
+	return nil, false
+}
+
+// ToResponse converts the errors into the response sent to the frontend.
+// Messages are translated via the form's context using the error's translation key;
+// placeholders {field}, {min}, {max}, ... are replaced with the translated field name and params.
+// If no translation exists, the English message is used.
+func (v ValidationErrors) ToResponse(fg *FormGroup) response.ValidationErrorResponse {
+	items := make([]response.FieldError, len(v))
+	for i, e := range v {
+		items[i] = response.FieldError{
+			Field:   e.Field,
+			Code:    string(e.Code),
+			Key:     e.TranslationKey(),
+			Message: fg.translateError(e),
+			Params:  e.Params,
+		}
+	}
+
+	message := "validation failed"
+	if len(v) > 0 {
+		message = items[0].Message
+	}
+	return response.NewValidationErrorResponse(message, items)
+}
+
+// translateError returns the localized message for a field error.
+func (fg *FormGroup) translateError(e *field.FieldError) string {
+	key := e.TranslationKey()
+	translated := fg.ctx.SafeTranslate(key)
+	if translated == "" || translated == key {
+		return e.Message
+	}
+
+	replacements := []string{"{field}", fg.GetTranslatedName(e.Field)}
+	for name, value := range e.Params {
+		replacements = append(replacements, "{"+name+"}", fmt.Sprint(value))
+	}
+	return strings.NewReplacer(replacements...).Replace(translated)
+}
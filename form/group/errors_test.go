@@ -0,0 +1,115 @@
+package group
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+func TestParseAndValidate_CollectsAllErrors(t *testing.T) {
+	fields := []field.FormField{
+		field.NewTextField("name", "NAME", true, ""),
+		field.NewTextFieldWithLength("code", "CODE", false, "", 3, 5),
+		field.NewIntFieldWithBounds("count", "COUNT", false, 0, 0, 10),
+		field.NewIntField("age", "AGE", false, 0),
+	}
+	fg := NewFormGroup(fields)
+
+	raw := map[string]interface{}{
+		"code":  "ab",
+		"count": float64(11),
+		"age":   "abc",
+	}
+	_, err := fg.ParseAndValidate(raw)
+	verrs, ok := AsValidationErrors(err)
+	if !ok {
+		t.Fatalf("expected ValidationErrors, got %T: %v", err, err)
+	}
+
+	expected := map[string]field.ErrorCode{
+		"name":  field.ErrCodeRequired,
+		"code":  field.ErrCodeMinLength,
+		"count": field.ErrCodeRange,
+		"age":   field.ErrCodeInvalid,
+	}
+	if len(verrs) != len(expected) {
+		t.Fatalf("expected %d errors, got %d: %v", len(expected), len(verrs), verrs)
+	}
+	for _, e := range verrs {
+		if expected[e.Field] != e.Code {
+			t.Errorf("field %s: expected code %s, got %s", e.Field, expected[e.Field], e.Code)
+		}
+	}
+
+	if verrs[1].Params["min"] != 3 {
+		t.Errorf("expected min param 3, got %v", verrs[1].Params["min"])
+	}
+	if verrs[1].TranslationKey() != "FORM_ERROR_MINLENGTH" {
+		t.Errorf("unexpected translation key %s", verrs[1].TranslationKey())
+	}
+}
+
+func TestValidationErrors_WrappedAndErrorString(t *testing.T) {
+	var errs ValidationErrors
+	errs.Add("name", fmt.Errorf("validating field name: %w", field.NewFieldError("", field.ErrCodeRequired, nil, "text field name is required")))
+	errs.Add("count", fmt.Errorf("boom"))
+
+	if errs[0].Field != "name" || errs[0].Code != field.ErrCodeRequired {
+		t.Errorf("unexpected first error: %+v", errs[0])
+	}
+	if errs[1].Code != field.ErrCodeInvalid || errs[1].Message != "boom" {
+		t.Errorf("unexpected second error: %+v", errs[1])
+	}
+	if errs.Error() != "text field name is required; boom" {
+		t.Errorf("unexpected error string: %s", errs.Error())
+	}
+
+	wrapped := fmt.Errorf("saving: %w", errs.OrNil())
+	if _, ok := AsValidationErrors(wrapped); !ok {
+		t.Error("expected wrapped ValidationErrors to be found")
+	}
+	if ValidationErrors(nil).OrNil() != nil {
+		t.Error("expected nil error for empty ValidationErrors")
+	}
+}
+
+func TestValidationErrors_ToResponse(t *testing.T) {
+	translations := map[string]string{
+		"FORM_ERROR_MINLENGTH": "{field} muss mindestens {min} Zeichen lang sein",
+		"CODE":                 "Code",
+	}
+	ctx := &uicontext.UiContext{Translate: func(key string) string {
+		if v, ok := translations[key]; ok {
+			return v
+		}
+		return key
+	}}
+
+	fg, err := NewFormGroupWithContext([]field.FormField{
+		field.NewTextFieldWithLength("code", "CODE", true, "", 3, 5),
+		field.NewTextField("name", "NAME", true, ""),
+	}, ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, err = fg.ParseAndValidate(map[string]interface{}{"code": "ab"})
+	verrs, _ := AsValidationErrors(err)
+	resp := verrs.ToResponse(fg)
+
+	if len(resp.Errors) != 2 {
+		t.Fatalf("expected 2 errors, got %d", len(resp.Errors))
+	}
+	if resp.Errors[0].Message != "Code muss mindestens 3 Zeichen lang sein" {
+		t.Errorf("unexpected translated message: %s", resp.Errors[0].Message)
+	}
+	if resp.Error != resp.Errors[0].Message {
+		t.Errorf("expected summary to be first message, got %s", resp.Error)
+	}
+	// No translation available: English fallback
+	if resp.Errors[1].Message != "required field name is missing" || resp.Errors[1].Code != "required" {
+		t.Errorf("unexpected fallback: %+v", resp.Errors[1])
+	}
+}
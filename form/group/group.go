@@ -2,6 +2,7 @@ package group
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/xiriframework/xiri-go/form/field"
 	"github.com/xiriframework/xiri-go/uicontext"
@@ -9,38 +10,44 @@ import (
 
 // FormGroup represents a collection of form fields
 type FormGroup struct {
-	fields []field.FormField
-	index  map[string]field.FormField // For quick lookup by ID
+	all    []field.FormField          // All fields, including those inactive in the current scope
+	fields []field.FormField          // Active fields (scenario and access applied)
+	index  map[string]field.FormField // For quick lookup by ID (active fields)
 	ctx    *uicontext.UiContext
+
+	scenario string            // Active scenario ("" = all fields)
+	access   PermissionChecker // Checker for field Access permissions (nil = Access not enforced, restricted fields active)
+
+	rules []Rule // Cross-field validation rules
+
+	// Field states from the last condition evaluation (see EvaluateConditions)
+	hidden   map[string]bool // Hidden by ShowWhen
+	disabled map[string]bool // Disabled by DisabledWhen
+	required map[string]bool // Required by RequiredWhen
+
+	dependentParents map[string]interface{} // Parent values of the loaded dependent options (see LoadDependentOptions)
 }
 
 // NewFormGroup creates a new form group without context
 // For backwards compatibility - use NewFormGroupWithContext for new code
 func NewFormGroup(fields []field.FormField) *FormGroup {
-	index := make(map[string]field.FormField)
-	for _, f := range fields {
-		index[f.GetID()] = f
+	fg := &FormGroup{
+		all: fields,
+		ctx: nil,
 	}
+	fg.applyScope()
 
-	return &FormGroup{
-		fields: fields,
-		index:  index,
-		ctx:    nil,
-	}
+	return fg
 }
 
 // NewFormGroupWithContext creates a new form group with user context
 func NewFormGroupWithContext(fields []field.FormField, ctx *uicontext.UiContext) (*FormGroup, error) {
-	index := make(map[string]field.FormField)
-	for _, f := range fields {
-		index[f.GetID()] = f
-	}
-
 	fg := &FormGroup{
-		fields: fields,
-		index:  index,
-		ctx:    ctx,
+		all: fields,
+		ctx: ctx,
 	}
+	fg.applyScope()
+	fg.bindContext()
 
 	// Auto-load field options for Model/ModelList fields
 	if err := fg.LoadFieldOptions(); err != nil {
@@ -51,14 +58,29 @@ func NewFormGroupWithContext(fields []field.FormField, ctx *uicontext.UiContext)
 }
 
 // SetContext sets the user context
-// Also triggers loading of field options for Model/ModelList fields
+// Also binds the context to ContextBinder fields (e.g., timezone for Time fields)
+// and triggers loading of field options for Model/ModelList fields
 func (fg *FormGroup) SetContext(ctx *uicontext.UiContext) error {
 	fg.ctx = ctx
+	fg.dependentParents = nil // options depend on the context
+	fg.bindContext()
 
 	// Auto-load field options when context is set
 	return fg.LoadFieldOptions()
 }
 
+// bindContext passes the context to all fields implementing ContextBinder.
+func (fg *FormGroup) bindContext() {
+	if fg.ctx == nil {
+		return
+	}
+	for _, f := range fg.all {
+		if binder, ok := f.(field.ContextBinder); ok {
+			binder.BindContext(fg.ctx)
+		}
+	}
+}
+
 // LoadFieldOptions loads dynamic options for fields that implement FieldOptionsLoader
 // This is called automatically when setting context, but can also be called manually
 func (fg *FormGroup) LoadFieldOptions() error {
@@ -75,10 +97,11 @@ func (fg *FormGroup) LoadFieldOptions() error {
 		}
 	}
 
-	return nil
+	// Dependent options for the current parent values
+	return fg.LoadDependentOptions(fg.GetDefaults())
 }
 
-// GetFields returns all fields in the group
+// GetFields returns the active fields in the group (see SetScenario and SetPermissionChecker)
 func (fg *FormGroup) GetFields() []field.FormField {
 	return fg.fields
 }
@@ -125,10 +148,16 @@ func (fg *FormGroup) GetOptionalFields() []field.FormField {
 }
 
 // GetTranslatedName returns the translated name for a field
-// If translation is not available, returns the original name/key
+// If translation is not available, returns the original name/key.
+// Row fields of a RepeaterField are addressed by their path ("contacts.1.phone").
 func (fg *FormGroup) GetTranslatedName(fieldID string) string {
 	f, exists := fg.index[fieldID]
 	if !exists {
+		// Row field of a repeater: "<repeater>.<row>.<field>"
+		parts := strings.SplitN(fieldID, ".", 3)
+		if repeater, ok := fg.index[parts[0]].(*RepeaterField); ok && len(parts) == 3 {
+			return repeater.Template.GetTranslatedName(parts[2])
+		}
 		return fieldID
 	}
 
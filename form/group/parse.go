@@ -1,12 +1,17 @@
 package group
 
 import (
-	"fmt"
+	"github.com/xiriframework/xiri-go/form/field"
 )
 
-// ParseValues parses raw field values into typed values
+// ParseValues parses raw field values into typed values.
+// All fields are parsed; failures are returned together as ValidationErrors.
+// Fields hidden or disabled by their conditions are dropped, including their errors
+// (see EvaluateConditions); fields required by RequiredWhen must be present.
 func (fg *FormGroup) ParseValues(raw map[string]interface{}) (map[string]interface{}, error) {
 	parsed := make(map[string]interface{})
+	fieldErrs := make(map[string]ValidationErrors)
+	missing := make(map[string]bool)
 
 	// Parse each field value
 	for _, f := range fg.fields {
@@ -14,8 +19,10 @@ func (fg *FormGroup) ParseValues(raw map[string]interface{}) (map[string]interfa
 
 		// If value doesn't exist, use default
 		if !exists {
+			missing[f.GetID()] = true
 			if f.IsRequired() {
-				return nil, fmt.Errorf("required field %s is missing", f.GetID())
+				fieldErrs[f.GetID()] = ValidationErrors{missingError(f.GetID())}
+				continue
 			}
 			// Use default value
 			if def := f.GetDefault(); def != nil {
@@ -27,47 +34,128 @@ func (fg *FormGroup) ParseValues(raw map[string]interface{}) (map[string]interfa
 		// Parse the value
 		value, err := f.Parse(rawValue)
 		if err != nil {
-			return nil, fmt.Errorf("error parsing field %s: %w", f.GetID(), err)
+			var fe ValidationErrors
+			fe.Add(f.GetID(), err)
+			fieldErrs[f.GetID()] = fe
+			continue
 		}
 
 		parsed[f.GetID()] = value
 	}
 
+	// Drop ignored fields (values sent for them are ignored)
+	ignored := fg.EvaluateConditions(parsed)
+	var errs ValidationErrors
+	for _, f := range fg.fields {
+		if ignored[f.GetID()] {
+			delete(parsed, f.GetID())
+			continue
+		}
+		if e, failed := fieldErrs[f.GetID()]; failed {
+			errs = append(errs, e...)
+		} else if missing[f.GetID()] && fg.IsFieldRequired(f) {
+			delete(parsed, f.GetID())
+			errs = append(errs, missingError(f.GetID()))
+		}
+	}
+
+	if len(errs) > 0 {
+		return parsed, errs
+	}
 	return parsed, nil
 }
 
-// ValidateValues validates parsed field values
+// ValidateValues validates parsed field values and the cross-field rules.
+// All fields are validated; failures are returned together as ValidationErrors.
+// Fields hidden or disabled by their conditions are neither required nor validated;
+// fields required by RequiredWhen must have a value. Dependent options are loaded for
+// the parent values first (see LoadDependentOptions).
 func (fg *FormGroup) ValidateValues(values map[string]interface{}) error {
+	return fg.validateValues(values, nil)
+}
+
+// validateValues validates the values; rules skip the fields in parseErrs, which
+// failed to parse and are therefore missing from values.
+func (fg *FormGroup) validateValues(values map[string]interface{}, parseErrs ValidationErrors) error {
+	if err := fg.LoadDependentOptions(values); err != nil {
+		return err
+	}
+
+	var errs ValidationErrors
+	ignored := fg.EvaluateConditions(values)
+
 	// Validate each field
 	for _, f := range fg.fields {
+		if ignored[f.GetID()] {
+			continue
+		}
 		value, exists := values[f.GetID()]
 
 		// Check required fields
-		if !exists && f.IsRequired() {
-			return fmt.Errorf("required field %s is missing", f.GetID())
+		if !exists && fg.IsFieldRequired(f) {
+			errs = append(errs, missingError(f.GetID()))
+			continue
 		}
 
 		// Validate the value
 		if err := f.Validate(value); err != nil {
-			return err
+			errs.Add(f.GetID(), err)
+		} else if e := fg.RequiredWhenError(f.GetID(), value); e != nil {
+			errs = append(errs, e)
 		}
 	}
 
-	return nil
+	fieldErrs := append(append(ValidationErrors{}, errs...), parseErrs...)
+	errs = append(errs, fg.ValidateRules(values, fieldErrs)...)
+	return errs.OrNil()
 }
 
 // ParseAndValidate is a convenience method that parses and validates in one call
 func (fg *FormGroup) ParseAndValidate(raw map[string]interface{}) (map[string]interface{}, error) {
 	// Parse values
 	parsed, err := fg.ParseValues(raw)
-	if err != nil {
-		return nil, err
-	}
+	parseErrs, _ := AsValidationErrors(err)
+
+	// Validate parsed values (fields that failed to parse are reported once)
+	validateErrs, _ := AsValidationErrors(fg.validateValues(parsed, parseErrs))
 
-	// Validate parsed values
-	if err := fg.ValidateValues(parsed); err != nil {
-		return nil, err
+	// Merge in field order
+	var errs ValidationErrors
+	for _, f := range fg.fields {
+		source := validateErrs
+		if parseErrs.Has(f.GetID()) {
+			source = parseErrs
+		}
+		for _, e := range source {
+			if errorBelongsTo(e, f.GetID()) {
+				errs = append(errs, e)
+			}
+		}
+	}
+	// Form-level rule errors not bound to a field
+	for _, e := range validateErrs {
+		if !fg.isFieldError(e) {
+			errs = append(errs, e)
+		}
 	}
 
+	if len(errs) > 0 {
+		return nil, errs
+	}
 	return parsed, nil
 }
+
+// isFieldError returns true if e belongs to one of the active fields (or their nested fields).
+func (fg *FormGroup) isFieldError(e *field.FieldError) bool {
+	for _, f := range fg.fields {
+		if errorBelongsTo(e, f.GetID()) {
+			return true
+		}
+	}
+	return false
+}
+
+// missingError returns the error for a required field that is missing from the input.
+func missingError(fieldID string) *field.FieldError {
+	return field.NewFieldError(fieldID, field.ErrCodeRequired, nil, "required field %s is missing", fieldID)
+}
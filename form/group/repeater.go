@@ -0,0 +1,334 @@
+package group
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/xiriframework/xiri-go/form/field"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// ============================================================================
+// Repeater Field (Repeatable Nested Sub-Forms)
+// ============================================================================
+//
+// A RepeaterField holds a list of rows; each row is a nested form defined by a
+// template FormGroup ("contacts, each with name, phone and role"). Rows are
+// parsed and validated by the template, so all field features (conditions,
+// rules, dependent options) apply per row. Errors of a row field are reported
+// with the path "<repeater>.<row>.<field>", e.g. "contacts.1.phone".
+//
+// Conditions of the template fields refer to fields of the same row only; fields
+// of the form containing the repeater cannot be referenced.
+//
+// The template is shared by all rows; parsing a RepeaterField is therefore not
+// safe for concurrent use (like the FormGroup itself).
+
+// RepeaterField represents a list of rows, each a nested form of the template fields.
+// Rows are maps from field ID to the parsed value; use RepeaterRows for typed access.
+type RepeaterField struct {
+	*field.BaseField
+	Template *FormGroup               // Fields of one row
+	MinRows  int                      // Minimum number of rows (0 = no minimum)
+	MaxRows  int                      // Maximum number of rows (0 = unlimited)
+	AddLabel string                   // Translation key of the "add row" button (empty = frontend default)
+	Value    []map[string]interface{} // Parsed and validated rows
+}
+
+// BindContext passes the user context to the template fields (e.g. timezone, units).
+func (f *RepeaterField) BindContext(ctx *uicontext.UiContext) {
+	f.Template.ctx = ctx
+	f.Template.bindContext()
+}
+
+// LoadOptions implements field.FieldOptionsLoader for the template fields.
+func (f *RepeaterField) LoadOptions(ctx *uicontext.UiContext) error {
+	f.Template.ctx = ctx
+	return f.Template.LoadFieldOptions()
+}
+
+// Validate checks the number of rows. The rows themselves are validated by Parse,
+// which parses each row through the template, so they are not validated twice.
+func (f *RepeaterField) Validate(value interface{}) error {
+	rows, ok := value.([]map[string]interface{})
+	if value != nil && !ok {
+		return field.NewFieldError(f.ID, field.ErrCodeInvalidType, nil, "invalid repeater value type for %s", f.ID)
+	}
+
+	if len(rows) == 0 && f.Required {
+		return field.NewFieldError(f.ID, field.ErrCodeRequired, nil, "repeater field %s is required", f.ID)
+	}
+	if f.MinRows > 0 && len(rows) < f.MinRows {
+		return field.NewFieldError(f.ID, field.ErrCodeMinItems, map[string]interface{}{"min": f.MinRows},
+			"repeater field %s must have at least %d rows", f.ID, f.MinRows)
+	}
+	return f.validateMaxRows(len(rows))
+}
+
+// validateMaxRows returns an error if there are more than MaxRows rows.
+func (f *RepeaterField) validateMaxRows(count int) error {
+	if f.MaxRows > 0 && count > f.MaxRows {
+		return field.NewFieldError(f.ID, field.ErrCodeMaxItems, map[string]interface{}{"max": f.MaxRows},
+			"repeater field %s must have at most %d rows", f.ID, f.MaxRows)
+	}
+	return nil
+}
+
+// Parse parses each row through the template into []map[string]interface{}.
+// Rows are parsed and validated together (FormGroup.ParseAndValidate), so all errors
+// of all rows are reported at once. More than MaxRows rows are rejected before any row
+// is parsed. Accepts a list of objects (JSON) or a JSON array string; nil uses the
+// default rows.
+func (f *RepeaterField) Parse(raw interface{}) (interface{}, error) {
+	if raw == nil {
+		raw = f.GetDefault()
+		if raw == nil {
+			return nil, nil
+		}
+	}
+
+	var rawRows []map[string]interface{}
+	switch v := raw.(type) {
+	case []map[string]interface{}:
+		rawRows = v
+	case []interface{}:
+		rawRows = make([]map[string]interface{}, len(v))
+		for i, item := range v {
+			row, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("repeater field %s: row %d is not an object", f.ID, i)
+			}
+			rawRows[i] = row
+		}
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil, nil
+		}
+		if err := json.Unmarshal([]byte(v), &rawRows); err != nil {
+			return nil, fmt.Errorf("repeater field %s: invalid rows: %w", f.ID, err)
+		}
+	default:
+		return nil, fmt.Errorf("cannot parse repeater rows from %T", raw)
+	}
+
+	if err := f.validateMaxRows(len(rawRows)); err != nil {
+		return nil, err
+	}
+
+	rows := make([]map[string]interface{}, len(rawRows))
+	var errs ValidationErrors
+	for i, rawRow := range rawRows {
+		row, err := f.Template.ParseAndValidate(rawRow)
+		if err != nil {
+			rowErrs, err := f.rowErrors(i, err)
+			if err != nil {
+				return nil, err
+			}
+			errs = append(errs, rowErrs...)
+		}
+		rows[i] = row
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return rows, nil
+}
+
+// BindValue parses, validates, and stores the rows in the field
+func (f *RepeaterField) BindValue(raw interface{}) error {
+	parsed, err := f.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("parsing field %s: %w", f.ID, err)
+	}
+	return f.BindParsed(parsed)
+}
+
+// BindParsed validates and stores rows already returned by Parse, so binding code
+// that parsed the value before (e.g. builder.BindFromMap) does not parse the rows again.
+func (f *RepeaterField) BindParsed(parsed interface{}) error {
+	if err := f.Validate(parsed); err != nil {
+		return fmt.Errorf("validating field %s: %w", f.ID, err)
+	}
+
+	f.Value, _ = parsed.([]map[string]interface{})
+	return nil
+}
+
+// rowErrors returns the validation errors of a row with their fields prefixed by
+// the row path; other errors (e.g. loading options) are returned as error.
+func (f *RepeaterField) rowErrors(row int, err error) (ValidationErrors, error) {
+	verrs, ok := AsValidationErrors(err)
+	if !ok {
+		return nil, fmt.Errorf("repeater field %s row %d: %w", f.ID, row, err)
+	}
+	prefix := f.ID + "." + strconv.Itoa(row)
+	result := make(ValidationErrors, len(verrs))
+	for i, e := range verrs {
+		nested := *e
+		if nested.Field == "" {
+			nested.Field = prefix
+		} else {
+			nested.Field = prefix + "." + nested.Field
+		}
+		result[i] = &nested
+	}
+	return result, nil
+}
+
+// RepeaterRows converts the bound rows of a RepeaterField into a typed slice.
+// Rows are converted through encoding/json, so the fields of T use json tags
+// matching the template field IDs.
+//
+// Example:
+//
+//	type Contact struct {
+//	    Name  string `json:"name"`
+//	    Phone string `json:"phone"`
+//	    Role  int32  `json:"role"`
+//	}
+//
+//	contacts, err := group.RepeaterRows[Contact](contactsField)
+func RepeaterRows[T any](f *RepeaterField) ([]T, error) {
+	rows := make([]T, len(f.Value))
+	for i, row := range f.Value {
+		data, err := json.Marshal(row)
+		if err != nil {
+			return nil, fmt.Errorf("repeater field %s row %d: %w", f.ID, i, err)
+		}
+		if err := json.Unmarshal(data, &rows[i]); err != nil {
+			return nil, fmt.Errorf("repeater field %s row %d: %w", f.ID, i, err)
+		}
+	}
+	return rows, nil
+}
+
+// ============================================================================
+// Builder Functions
+// ============================================================================
+
+// NewRepeaterField creates a repeater field with rows of the template fields
+//
+// Example:
+//
+//	contacts := group.NewRepeaterField("contacts", "CONTACTS", true, group.NewFormGroup([]field.FormField{
+//	    field.NewTextField("name", "NAME", true, ""),
+//	    field.NewTextField("phone", "PHONE", false, ""),
+//	    field.NewSelectField("role", "ROLE", true, roleOptions),
+//	})).SetMaxRows(10)
+func NewRepeaterField(id, name string, required bool, template *FormGroup) *RepeaterField {
+	return &RepeaterField{
+		BaseField: &field.BaseField{
+			ID:       id,
+			Type:     field.FieldTypeRepeater,
+			Name:     name,
+			Required: required,
+			Form:     true,
+		},
+		Template: template,
+	}
+}
+
+// ExportForFrontend exports the field with the template field definitions, the template's
+// frontend rules and the rows
+func (f *RepeaterField) ExportForFrontend(ctx *uicontext.UiContext, value interface{}) map[string]interface{} {
+	if value == nil {
+		value = f.GetDefault()
+	}
+	rows, _ := value.([]map[string]interface{})
+	if rows == nil {
+		rows = []map[string]interface{}{}
+	}
+	result := f.BaseField.GetBaseExport(ctx, rows)
+
+	result["fields"] = f.Template.ExportForFrontend()
+	if rules := f.Template.ExportRules(); len(rules) > 0 {
+		result["rules"] = rules
+	}
+	if f.MinRows > 0 {
+		result["min"] = f.MinRows
+	}
+	if f.MaxRows > 0 {
+		result["max"] = f.MaxRows
+	}
+	if f.AddLabel != "" {
+		if ctx != nil && ctx.Translate != nil {
+			result["addLabel"] = ctx.Translate(f.AddLabel)
+		} else {
+			result["addLabel"] = f.AddLabel
+		}
+	}
+
+	return result
+}
+
+// ============================================================================
+// Chainable Setter Methods
+// ============================================================================
+
+// SetMinRows sets the minimum number of rows (0 = no minimum)
+func (f *RepeaterField) SetMinRows(min int) *RepeaterField {
+	f.MinRows = min
+	return f
+}
+
+// SetMaxRows sets the maximum number of rows (0 = unlimited)
+func (f *RepeaterField) SetMaxRows(max int) *RepeaterField {
+	f.MaxRows = max
+	return f
+}
+
+// SetRows sets the current rows (e.g. loaded contacts for the Edit workflow)
+func (f *RepeaterField) SetRows(rows []map[string]interface{}) *RepeaterField {
+	f.Default = rows
+	return f
+}
+
+// SetAddLabel sets the translation key of the "add row" button
+func (f *RepeaterField) SetAddLabel(label string) *RepeaterField {
+	f.AddLabel = label
+	return f
+}
+
+// SetClass sets the CSS class for frontend styling
+func (f *RepeaterField) SetClass(class string) *RepeaterField {
+	f.BaseField.SetClass(class)
+	return f
+}
+
+// SetHint sets the tooltip/help text for the field
+func (f *RepeaterField) SetHint(hint string) *RepeaterField {
+	f.BaseField.SetHint(hint)
+	return f
+}
+
+// SetStep sets the step indicator for multi-step forms
+func (f *RepeaterField) SetStep(step int) *RepeaterField {
+	f.BaseField.SetStep(step)
+	return f
+}
+
+// SetDisabled sets whether the field is disabled
+func (f *RepeaterField) SetDisabled(disabled bool) *RepeaterField {
+	f.BaseField.SetDisabled(disabled)
+	return f
+}
+
+// SetAccess sets the access control permissions
+func (f *RepeaterField) SetAccess(access []string) *RepeaterField {
+	f.BaseField.SetAccess(access)
+	return f
+}
+
+// SetScenario sets which scenarios this field applies to
+func (f *RepeaterField) SetScenario(scenario []string) *RepeaterField {
+	f.BaseField.SetScenario(scenario)
+	return f
+}
+
+// SetForm sets whether to show in form
+func (f *RepeaterField) SetForm(form bool) *RepeaterField {
+	f.BaseField.SetForm(form)
+	return f
+}
@@ -0,0 +1,111 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/xiriframework/xiri-go/form/field"
+)
+
+type testContact struct {
+	Name  string `json:"name"`
+	Phone string `json:"phone"`
+	Role  int32  `json:"role"`
+}
+
+func contactsField() *RepeaterField {
+	return NewRepeaterField("contacts", "CONTACTS", true, NewFormGroup([]field.FormField{
+		field.NewTextField("name", "NAME", true, ""),
+		field.NewTextFieldWithLength("phone", "PHONE", false, "", 0, 10),
+		field.NewSelectField("role", "ROLE", true, []field.SelectOption{
+			{Value: int32(1), Label: "DRIVER"},
+			{Value: int32(2), Label: "DISPATCHER"},
+		}),
+	})).SetMaxRows(3)
+}
+
+func TestRepeaterField_BindRows(t *testing.T) {
+	contacts := contactsField()
+	raw := []interface{}{
+		map[string]interface{}{"name": "Anna", "phone": "+43 1", "role": float64(2)},
+		map[string]interface{}{"name": "Ben", "role": float64(1)},
+	}
+	if err := contacts.BindValue(raw); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rows, err := RepeaterRows[testContact](contacts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []testContact{{"Anna", "+43 1", 2}, {"Ben", "", 1}}
+	if len(rows) != 2 || rows[0] != want[0] || rows[1] != want[1] {
+		t.Errorf("expected %+v, got %+v", want, rows)
+	}
+}
+
+func TestRepeaterField_RowErrors(t *testing.T) {
+	fg := NewFormGroup([]field.FormField{field.NewTextField("title", "TITLE", true, ""), contactsField()})
+
+	_, err := fg.ParseAndValidate(map[string]interface{}{
+		"title": "Depot",
+		"contacts": []interface{}{
+			map[string]interface{}{"name": "Anna", "role": float64(1)},
+			map[string]interface{}{"phone": "+43 1 234 567 89", "role": float64(1)},
+			map[string]interface{}{"name": "Carl", "role": float64(7)},
+		},
+	})
+	verrs, ok := AsValidationErrors(err)
+	if !ok {
+		t.Fatalf("expected ValidationErrors, got %v", err)
+	}
+	expected := map[string]field.ErrorCode{
+		"contacts.1.name":  field.ErrCodeRequired,
+		"contacts.1.phone": field.ErrCodeMaxLength,
+		"contacts.2.role":  field.ErrCodeInvalidOption,
+	}
+	if len(verrs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), verrs)
+	}
+	for _, e := range verrs {
+		if expected[e.Field] != e.Code {
+			t.Errorf("unexpected error %s: %s", e.Field, e.Code)
+		}
+	}
+	if name := fg.GetTranslatedName("contacts.1.phone"); name != "PHONE" {
+		t.Errorf("expected row field name PHONE, got %q", name)
+	}
+
+	// Row count limits are checked before the rows are parsed (no row errors)
+	tooMany := make([]interface{}, 4)
+	for i := range tooMany {
+		tooMany[i] = map[string]interface{}{"role": float64(9)}
+	}
+	_, err = fg.ParseAndValidate(map[string]interface{}{"title": "Depot", "contacts": tooMany})
+	if verrs, _ := AsValidationErrors(err); len(verrs) != 1 || verrs[0].Code != field.ErrCodeMaxItems {
+		t.Errorf("expected maxItems error, got %v", err)
+	}
+}
+
+func TestRepeaterField_Export(t *testing.T) {
+	contacts := contactsField().SetRows([]map[string]interface{}{{"name": "Anna", "role": int32(1)}})
+	result := contacts.ExportForFrontend(nil, nil)
+
+	if result["type"] != "repeater" || result["max"] != 3 {
+		t.Errorf("unexpected export: %v", result)
+	}
+	if fields := result["fields"].([]map[string]interface{}); len(fields) != 3 || fields[2]["id"] != "role" {
+		t.Errorf("unexpected template fields: %v", result["fields"])
+	}
+	if rows := result["value"].([]map[string]interface{}); len(rows) != 1 || rows[0]["name"] != "Anna" {
+		t.Errorf("unexpected rows: %v", result["value"])
+	}
+	if _, ok := result["rules"]; ok {
+		t.Errorf("expected no rules without template rules, got %v", result["rules"])
+	}
+
+	contacts.Template.AddRule(AtLeastOne("phone", "name"))
+	result = contacts.ExportForFrontend(nil, nil)
+	if rules, ok := result["rules"].([]map[string]interface{}); !ok || len(rules) != 1 {
+		t.Errorf("expected template rules in export, got %v", result["rules"])
+	}
+}
@@ -0,0 +1,323 @@
+package group
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/xiriframework/xiri-go/form/field"
+)
+
+// ============================================================================
+// Cross-Field Validation Rules
+// ============================================================================
+//
+// Rules validate several fields together after each field passed its own
+// validation. They see all parsed values and report errors against specific
+// field IDs. Rules built from Condition operators are exported to the frontend
+// via ExportRules() (included in the form component by form.Form.WithRules and
+// builder.FormBuilder.BuildForm, and in repeater exports), so client and server
+// apply the same checks.
+//
+// Fields that are required depending on other values use BaseField.SetRequiredWhen
+// instead of a rule, so the condition is evaluated together with ShowWhen and
+// DisabledWhen (see EvaluateConditions).
+//
+// Example:
+//
+//	fg.AddRule(
+//	    group.CompareFields("end", field.CondGreater, "start"),
+//	    group.AtLeastOne("phone", "email"),
+//	    group.FieldsMatch("passwordConfirm", "password"),
+//	    group.NewRule([]string{"budget"}, func(v group.Values) error {
+//	        if v.Float("budget") > v.Float("limit") {
+//	            return errors.New("budget exceeds limit")
+//	        }
+//	        return nil
+//	    }),
+//	)
+
+// Error codes for cross-field rules
+const (
+	ErrCodeCompare    field.ErrorCode = "compare"    // Comparison with another field failed (params: operator, other)
+	ErrCodeAtLeastOne field.ErrorCode = "atLeastOne" // None of the fields has a value (params: fields)
+	ErrCodeMismatch   field.ErrorCode = "mismatch"   // Value differs from another field (params: other)
+	ErrCodeRule       field.ErrorCode = "rule"       // Custom rule failed
+)
+
+// Rule is a form-level validation rule.
+type Rule interface {
+	// Fields returns the IDs of all fields the rule reads. The rule is skipped if any
+	// of them is inactive (scenario/access), hidden or disabled by a condition or already
+	// has a field error.
+	Fields() []string
+
+	// Check validates the parsed values and returns errors against specific field IDs.
+	Check(values Values) []*field.FieldError
+}
+
+// ExportableRule is a rule that can be evaluated by the frontend.
+type ExportableRule interface {
+	Rule
+
+	// Export returns the frontend definition of the rule.
+	Export() map[string]interface{}
+}
+
+// Values provides typed access to the parsed form values.
+// Missing or mismatching values return the zero value.
+type Values map[string]interface{}
+
+// String returns the value as string.
+func (v Values) String(id string) string {
+	switch s := v[id].(type) {
+	case nil:
+		return ""
+	case string:
+		return s
+	default:
+		return fmt.Sprint(s)
+	}
+}
+
+// Int returns the value as int64 (numbers of any type).
+func (v Values) Int(id string) int64 {
+	return int64(v.Float(id))
+}
+
+// Float returns the value as float64 (numbers of any type).
+func (v Values) Float(id string) float64 {
+	switch n := v[id].(type) {
+	case int:
+		return float64(n)
+	case int32:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case float32:
+		return float64(n)
+	case float64:
+		return n
+	}
+	return 0
+}
+
+// Bool returns the value as bool.
+func (v Values) Bool(id string) bool {
+	b, _ := v[id].(bool)
+	return b
+}
+
+// Time returns the value as time (Unix timestamps from TimeField or time.Time).
+func (v Values) Time(id string) time.Time {
+	switch t := v[id].(type) {
+	case time.Time:
+		return t
+	case int64:
+		return time.Unix(t, 0)
+	case int:
+		return time.Unix(int64(t), 0)
+	}
+	return time.Time{}
+}
+
+// IsEmpty returns true if the value is missing or empty (see field.IsEmptyValue).
+func (v Values) IsEmpty(id string) bool {
+	return field.IsEmptyValue(v[id])
+}
+
+// AddRule adds cross-field validation rules, checked by ValidateValues and ParseAndValidate.
+func (fg *FormGroup) AddRule(rules ...Rule) *FormGroup {
+	fg.rules = append(fg.rules, rules...)
+	return fg
+}
+
+// GetRules returns the rules of the group.
+func (fg *FormGroup) GetRules() []Rule {
+	return fg.rules
+}
+
+// ValidateRules checks all rules against the parsed values.
+// Rules referencing inactive or ignored fields (see EvaluateConditions) or fields
+// listed in fieldErrs are skipped, so each field reports its own error first.
+func (fg *FormGroup) ValidateRules(values map[string]interface{}, fieldErrs ValidationErrors) ValidationErrors {
+	var errs ValidationErrors
+rules:
+	for _, rule := range fg.rules {
+		for _, id := range rule.Fields() {
+			if _, active := fg.index[id]; !active || fg.IsFieldIgnored(id) || fieldErrs.Has(id) {
+				continue rules
+			}
+		}
+		errs = append(errs, rule.Check(values)...)
+	}
+	return errs
+}
+
+// ExportRules exports the rules that can be evaluated by the frontend.
+// Rules referencing inactive fields are omitted.
+func (fg *FormGroup) ExportRules() []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+rules:
+	for _, rule := range fg.rules {
+		exportable, ok := rule.(ExportableRule)
+		if !ok {
+			continue
+		}
+		for _, id := range rule.Fields() {
+			if _, active := fg.index[id]; !active {
+				continue rules
+			}
+		}
+		result = append(result, exportable.Export())
+	}
+	return result
+}
+
+// ============================================================================
+// Built-in Rules
+// ============================================================================
+
+// compareRule compares a field against another field.
+type compareRule struct {
+	field    string
+	operator field.ConditionOperator
+	other    string
+}
+
+// CompareFields requires values[fieldID] <operator> values[otherID], e.g. "end after start".
+// Empty values are not compared (use Required for that). The error is reported against fieldID.
+//
+// Example:
+//
+//	group.CompareFields("end", field.CondGreater, "start")
+func CompareFields(fieldID string, operator field.ConditionOperator, otherID string) Rule {
+	return &compareRule{field: fieldID, operator: operator, other: otherID}
+}
+
+func (r *compareRule) Fields() []string {
+	return []string{r.field, r.other}
+}
+
+func (r *compareRule) Check(values Values) []*field.FieldError {
+	if values.IsEmpty(r.field) || values.IsEmpty(r.other) {
+		return nil
+	}
+	if field.CompareValues(values[r.field], r.operator, values[r.other]) {
+		return nil
+	}
+	return []*field.FieldError{field.NewFieldError(r.field, ErrCodeCompare,
+		map[string]interface{}{"operator": string(r.operator), "other": r.other},
+		"field %s must be %s field %s", r.field, r.operator, r.other)}
+}
+
+func (r *compareRule) Export() map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "compare",
+		"field":    r.field,
+		"operator": r.operator,
+		"other":    r.other,
+	}
+}
+
+// atLeastOneRule requires at least one non-empty value.
+type atLeastOneRule struct {
+	fields []string
+}
+
+// AtLeastOne requires a value in at least one of the fields (e.g. phone or email).
+// The error is reported against every listed field.
+func AtLeastOne(fieldIDs ...string) Rule {
+	return &atLeastOneRule{fields: fieldIDs}
+}
+
+func (r *atLeastOneRule) Fields() []string {
+	return r.fields
+}
+
+func (r *atLeastOneRule) Check(values Values) []*field.FieldError {
+	for _, id := range r.fields {
+		if !values.IsEmpty(id) {
+			return nil
+		}
+	}
+	errs := make([]*field.FieldError, len(r.fields))
+	for i, id := range r.fields {
+		errs[i] = field.NewFieldError(id, ErrCodeAtLeastOne, map[string]interface{}{"fields": r.fields},
+			"at least one of %v is required", r.fields)
+	}
+	return errs
+}
+
+func (r *atLeastOneRule) Export() map[string]interface{} {
+	return map[string]interface{}{
+		"type":   "atLeastOne",
+		"fields": r.fields,
+	}
+}
+
+// matchRule requires two fields to have the same value.
+type matchRule struct {
+	field string
+	other string
+}
+
+// FieldsMatch requires fieldID to equal otherID (e.g. password confirmation).
+// The error is reported against fieldID.
+func FieldsMatch(fieldID, otherID string) Rule {
+	return &matchRule{field: fieldID, other: otherID}
+}
+
+func (r *matchRule) Fields() []string {
+	return []string{r.field, r.other}
+}
+
+func (r *matchRule) Check(values Values) []*field.FieldError {
+	if field.CompareValues(values[r.field], field.CondEquals, values[r.other]) {
+		return nil
+	}
+	return []*field.FieldError{field.NewFieldError(r.field, ErrCodeMismatch, map[string]interface{}{"other": r.other},
+		"field %s must match field %s", r.field, r.other)}
+}
+
+func (r *matchRule) Export() map[string]interface{} {
+	return map[string]interface{}{
+		"type":     "compare",
+		"field":    r.field,
+		"operator": field.CondEquals,
+		"other":    r.other,
+	}
+}
+
+// funcRule is a custom rule.
+type funcRule struct {
+	fields []string
+	fn     func(values Values) error
+}
+
+// NewRule creates a custom rule. A returned *field.FieldError is reported as is;
+// any other error is reported against the first field with code ErrCodeRule.
+// Custom rules are not exported to the frontend.
+func NewRule(fieldIDs []string, fn func(values Values) error) Rule {
+	return &funcRule{fields: fieldIDs, fn: fn}
+}
+
+func (r *funcRule) Fields() []string {
+	return r.fields
+}
+
+func (r *funcRule) Check(values Values) []*field.FieldError {
+	err := r.fn(values)
+	if err == nil {
+		return nil
+	}
+	var fe *field.FieldError
+	if errors.As(err, &fe) {
+		return []*field.FieldError{fe}
+	}
+	fieldID := ""
+	if len(r.fields) > 0 {
+		fieldID = r.fields[0]
+	}
+	return []*field.FieldError{{Field: fieldID, Code: ErrCodeRule, Message: err.Error()}}
+}
@@ -0,0 +1,140 @@
+package group
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/xiriframework/xiri-go/form/field"
+)
+
+func TestRules_CompareFields(t *testing.T) {
+	fg := NewFormGroup([]field.FormField{
+		field.NewTimeField("start", "START", true, 0),
+		field.NewTimeField("end", "END", true, 0),
+	}).AddRule(CompareFields("end", field.CondGreater, "start"))
+
+	_, err := fg.ParseAndValidate(map[string]interface{}{"start": "2024-01-02", "end": "2024-01-01"})
+	verrs, ok := AsValidationErrors(err)
+	if !ok || len(verrs) != 1 {
+		t.Fatalf("expected 1 error, got %v", err)
+	}
+	if verrs[0].Field != "end" || verrs[0].Code != ErrCodeCompare {
+		t.Errorf("unexpected error: %+v", verrs[0])
+	}
+
+	if _, err := fg.ParseAndValidate(map[string]interface{}{"start": "2024-01-01", "end": "2024-01-02"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRules_AtLeastOne(t *testing.T) {
+	fg := NewFormGroup([]field.FormField{
+		field.NewTextField("phone", "PHONE", false, ""),
+		field.NewTextField("email", "EMAIL", false, ""),
+	}).AddRule(AtLeastOne("phone", "email"))
+
+	_, err := fg.ParseAndValidate(map[string]interface{}{})
+	verrs, _ := AsValidationErrors(err)
+	if len(verrs) != 2 || !verrs.Has("phone") || !verrs.Has("email") || verrs[0].Code != ErrCodeAtLeastOne {
+		t.Errorf("expected atLeastOne errors on both fields, got %v", err)
+	}
+
+	if _, err := fg.ParseAndValidate(map[string]interface{}{"email": "a@b.cd"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRules_FieldsMatch(t *testing.T) {
+	fg := NewFormGroup([]field.FormField{
+		field.NewTextField("password", "PASSWORD", true, ""),
+		field.NewTextField("confirm", "CONFIRM", true, ""),
+	}).AddRule(FieldsMatch("confirm", "password"))
+
+	_, err := fg.ParseAndValidate(map[string]interface{}{
+		"password": "secret",
+		"confirm":  "secrte",
+	})
+	verrs, _ := AsValidationErrors(err)
+	if len(verrs) != 1 || verrs[0].Field != "confirm" || verrs[0].Code != ErrCodeMismatch {
+		t.Fatalf("expected mismatch error on confirm, got %v", err)
+	}
+
+	_, err = fg.ParseAndValidate(map[string]interface{}{
+		"password": "secret",
+		"confirm":  "secret",
+	})
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRules_SkippedForFailingOrInactiveFields(t *testing.T) {
+	fg := NewFormGroup([]field.FormField{
+		field.NewTextField("password", "PASSWORD", true, ""),
+		field.NewTextField("confirm", "CONFIRM", false, "").SetScenario([]string{ScenarioAdd}),
+	}).AddRule(FieldsMatch("confirm", "password"))
+
+	// Required error on password only, no mismatch noise
+	_, err := fg.ParseAndValidate(map[string]interface{}{"confirm": "x"})
+	verrs, _ := AsValidationErrors(err)
+	if len(verrs) != 1 || verrs[0].Code != field.ErrCodeRequired {
+		t.Errorf("expected only required error, got %v", err)
+	}
+
+	// Fields that failed to parse do not trigger rules
+	counts := NewFormGroup([]field.FormField{
+		field.NewIntField("cars", "CARS", false, 0),
+		field.NewIntField("trucks", "TRUCKS", false, 0),
+	}).AddRule(AtLeastOne("cars", "trucks"))
+	_, err = counts.ParseAndValidate(map[string]interface{}{"cars": "many"})
+	verrs, _ = AsValidationErrors(err)
+	if len(verrs) != 1 || verrs[0].Field != "cars" || verrs[0].Code == ErrCodeAtLeastOne {
+		t.Errorf("expected only the parse error on cars, got %v", err)
+	}
+
+	// Rule skipped when confirm is inactive
+	fg.SetScenario(ScenarioEdit)
+	if _, err := fg.ParseAndValidate(map[string]interface{}{"password": "x"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(fg.ExportRules()) != 0 {
+		t.Error("expected rule with inactive field not to be exported")
+	}
+}
+
+func TestRules_CustomRuleAndTypedValues(t *testing.T) {
+	fg := NewFormGroup([]field.FormField{
+		field.NewIntField("budget", "BUDGET", true, 0),
+		field.NewIntField("limit", "LIMIT", true, 0),
+	}).AddRule(NewRule([]string{"budget", "limit"}, func(v Values) error {
+		if v.Int("budget") > v.Int("limit") {
+			return errors.New("budget exceeds limit")
+		}
+		return nil
+	}))
+
+	_, err := fg.ParseAndValidate(map[string]interface{}{"budget": float64(200), "limit": float64(100)})
+	verrs, _ := AsValidationErrors(err)
+	if len(verrs) != 1 || verrs[0].Field != "budget" || verrs[0].Code != ErrCodeRule {
+		t.Errorf("unexpected errors: %v", err)
+	}
+	if len(fg.ExportRules()) != 0 {
+		t.Error("custom rules must not be exported")
+	}
+}
+
+func TestRules_Export(t *testing.T) {
+	fg := NewFormGroup([]field.FormField{
+		field.NewTimeField("start", "START", true, 0),
+		field.NewTimeField("end", "END", true, 0),
+	}).AddRule(CompareFields("end", field.CondGreater, "start"))
+
+	exported := fg.ExportRules()
+	if len(exported) != 1 {
+		t.Fatalf("expected 1 exported rule, got %d", len(exported))
+	}
+	rule := exported[0]
+	if rule["type"] != "compare" || rule["field"] != "end" || rule["operator"] != field.CondGreater || rule["other"] != "start" {
+		t.Errorf("unexpected export: %v", rule)
+	}
+}
@@ -0,0 +1,121 @@
+package group
+
+import (
+	"github.com/xiriframework/xiri-go/form/field"
+)
+
+// ============================================================================
+// Scenarios and Access Control
+// ============================================================================
+//
+// A FormGroup can be restricted to an active scenario and a permission checker.
+// Fields that do not apply (BaseField.Scenario does not contain the scenario,
+// or BaseField.Access is not granted by the checker) are dropped from the group:
+// they are not exported, parsed, validated or bound. This prevents over-posting
+// into fields the current user or workflow must not change. Access is opt-in:
+// without a permission checker, fields with Access remain active.
+//
+// Example:
+//
+//	status := field.NewSelectField("status", "STATUS", true, statusOptions).
+//	    SetScenario([]string{group.ScenarioEdit}).
+//	    SetAccess([]string{"device.admin"})
+//
+//	fg := group.NewFormGroup(fields).
+//	    SetScenario(group.ScenarioEdit).
+//	    SetPermissionChecker(group.PermissionFunc(user.HasPermission))
+
+// Standard scenarios. Any other string can be used as a custom scenario.
+const (
+	ScenarioAdd       = "add"
+	ScenarioEdit      = "edit"
+	ScenarioMultiEdit = "multiedit"
+)
+
+// PermissionChecker decides whether the current user has a permission.
+// The implementation is project-specific (e.g., backed by the user's roles).
+type PermissionChecker interface {
+	HasPermission(permission string) bool
+}
+
+// PermissionFunc adapts a function to the PermissionChecker interface.
+type PermissionFunc func(permission string) bool
+
+// HasPermission calls f(permission).
+func (f PermissionFunc) HasPermission(permission string) bool {
+	return f(permission)
+}
+
+// scopedField is implemented by all fields embedding *field.BaseField.
+type scopedField interface {
+	AppliesToScenario(scenario string) bool
+	GetAccess() []string
+}
+
+// SetScenario sets the active scenario (e.g. ScenarioAdd, ScenarioEdit or a custom one).
+// Only fields without Scenario or with the scenario in their Scenario list remain active.
+// An empty scenario activates all fields.
+func (fg *FormGroup) SetScenario(scenario string) *FormGroup {
+	fg.scenario = scenario
+	fg.applyScope()
+	return fg
+}
+
+// GetScenario returns the active scenario ("" = none).
+func (fg *FormGroup) GetScenario() string {
+	return fg.scenario
+}
+
+// SetPermissionChecker sets the checker for BaseField.Access.
+// A field with Access is active if the checker grants at least one of its permissions.
+// Without a checker, Access is not enforced: restricted fields are active and accept
+// submitted values, so forms with Access fields must always set a checker.
+func (fg *FormGroup) SetPermissionChecker(checker PermissionChecker) *FormGroup {
+	fg.access = checker
+	fg.applyScope()
+	return fg
+}
+
+// GetAllFields returns all fields of the group, including those dropped by scenario or access.
+func (fg *FormGroup) GetAllFields() []field.FormField {
+	return fg.all
+}
+
+// IsFieldActive returns true if the field applies to the active scenario and is accessible.
+// Access is only enforced once a permission checker is set; without a checker, fields
+// with Access stay active (as before access control was added).
+func (fg *FormGroup) IsFieldActive(f field.FormField) bool {
+	scoped, ok := f.(scopedField)
+	if !ok {
+		return true
+	}
+	if !scoped.AppliesToScenario(fg.scenario) {
+		return false
+	}
+
+	access := scoped.GetAccess()
+	if len(access) == 0 {
+		return true
+	}
+	if fg.access == nil {
+		return true
+	}
+	for _, permission := range access {
+		if fg.access.HasPermission(permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyScope rebuilds the active field list and index from all fields.
+func (fg *FormGroup) applyScope() {
+	fg.fields = make([]field.FormField, 0, len(fg.all))
+	fg.index = make(map[string]field.FormField, len(fg.all))
+	for _, f := range fg.all {
+		if fg.IsFieldActive(f) {
+			fg.fields = append(fg.fields, f)
+			fg.index[f.GetID()] = f
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package group
+
+import (
+	"testing"
+
+	"github.com/xiriframework/xiri-go/form/field"
+)
+
+func scenarioTestFields() []field.FormField {
+	return []field.FormField{
+		field.NewTextField("name", "NAME", true, ""),
+		field.NewTextField("serial", "SERIAL", false, "").SetScenario([]string{ScenarioAdd}),
+		field.NewTextField("status", "STATUS", false, "").SetScenario([]string{ScenarioEdit, ScenarioMultiEdit}),
+		field.NewTextField("secret", "SECRET", false, "").SetAccess([]string{"admin", "support"}),
+	}
+}
+
+func TestFormGroup_Scenario(t *testing.T) {
+	tests := []struct {
+		scenario string
+		expected []string
+	}{
+		{"", []string{"name", "serial", "status", "secret"}},
+		{ScenarioAdd, []string{"name", "serial", "secret"}},
+		{ScenarioEdit, []string{"name", "status", "secret"}},
+		{ScenarioMultiEdit, []string{"name", "status", "secret"}},
+		{"custom", []string{"name", "secret"}},
+	}
+
+	for _, tt := range tests {
+		fg := NewFormGroup(scenarioTestFields()).SetScenario(tt.scenario)
+		ids := fg.GetFieldIDs()
+		if len(ids) != len(tt.expected) {
+			t.Errorf("scenario %q: expected %v, got %v", tt.scenario, tt.expected, ids)
+			continue
+		}
+		for i := range ids {
+			if ids[i] != tt.expected[i] {
+				t.Errorf("scenario %q: expected %v, got %v", tt.scenario, tt.expected, ids)
+				break
+			}
+		}
+	}
+}
+
+func TestFormGroup_PermissionChecker(t *testing.T) {
+	fg := NewFormGroup(scenarioTestFields())
+	if _, ok := fg.GetField("secret"); !ok {
+		t.Error("expected restricted field to stay active without permission checker")
+	}
+	if len(fg.GetAllFields()) != 4 {
+		t.Errorf("expected 4 fields in total, got %d", len(fg.GetAllFields()))
+	}
+
+	fg.SetPermissionChecker(PermissionFunc(func(p string) bool { return p == "support" }))
+	if _, ok := fg.GetField("secret"); !ok {
+		t.Error("expected restricted field to be active with granted permission")
+	}
+
+	fg.SetPermissionChecker(PermissionFunc(func(p string) bool { return false }))
+	if _, ok := fg.GetField("secret"); ok {
+		t.Error("expected restricted field to be inactive without granted permission")
+	}
+}
+
+func TestFormGroup_InactiveFieldsNotParsedOrExported(t *testing.T) {
+	fg := NewFormGroup(scenarioTestFields()).SetScenario(ScenarioEdit).
+		SetPermissionChecker(PermissionFunc(func(p string) bool { return false }))
+
+	raw := map[string]interface{}{
+		"name":   "truck",
+		"serial": "over-posted",
+		"status": "active",
+		"secret": "over-posted",
+	}
+	parsed, err := fg.ParseAndValidate(raw)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := parsed["serial"]; ok {
+		t.Error("expected serial to be ignored in edit scenario")
+	}
+	if _, ok := parsed["secret"]; ok {
+		t.Error("expected secret to be ignored without permission")
+	}
+	if parsed["status"] != "active" {
+		t.Errorf("expected status 'active', got %v", parsed["status"])
+	}
+
+	exported := fg.ExportForFrontend()
+	if len(exported) != 2 {
+		t.Errorf("expected 2 exported fields, got %d", len(exported))
+	}
+}
@@ -2,6 +2,8 @@ package formatter
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/xiriframework/xiri-go/types/distance"
 	"github.com/xiriframework/xiri-go/types/locale"
@@ -34,6 +36,77 @@ func FormatNumberLocale(value float64, decimals int, loc locale.Locale) string {
 	return addThousandSeparatorsLocale(str, ',', '.')
 }
 
+// UsesCommaDecimal returns true if the locale writes numbers as "1.234,56"
+// (see FormatNumberLocale), false for "1,234.56".
+func UsesCommaDecimal(loc locale.Locale) bool {
+	return usesCommaDecimal(loc)
+}
+
+// NormalizeNumberLocale converts a number in the user's locale into the canonical
+// form "-1234.56" accepted by strconv, using the separators of FormatNumberLocale.
+// Thousands separators are optional but must group three digits.
+//
+// Example:
+//
+//	NormalizeNumberLocale("1.234,56", locale.De)   // "1234.56"
+//	NormalizeNumberLocale("1,234.56", locale.EnUS) // "1234.56"
+func NormalizeNumberLocale(value string, loc locale.Locale) (string, error) {
+	thousandsSep, decimalSep := ",", "."
+	if usesCommaDecimal(loc) {
+		thousandsSep, decimalSep = ".", ","
+	}
+
+	s := strings.TrimSpace(value)
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		if s[0] == '-' {
+			sign = "-"
+		}
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasFrac := strings.Cut(s, decimalSep)
+	if hasFrac && (fracPart == "" || !isDigits(fracPart)) {
+		return "", fmt.Errorf("invalid number: %q", value)
+	}
+
+	groups := strings.Split(intPart, thousandsSep)
+	for i, group := range groups {
+		valid := isDigits(group) && (len(groups) == 1 || (i == 0 && len(group) <= 3) || len(group) == 3)
+		if !valid {
+			return "", fmt.Errorf("invalid number: %q", value)
+		}
+	}
+
+	result := sign + strings.Join(groups, "")
+	if hasFrac {
+		result += "." + fracPart
+	}
+	return result, nil
+}
+
+// ParseNumberLocale parses a number in the user's locale (see NormalizeNumberLocale).
+func ParseNumberLocale(value string, loc locale.Locale) (float64, error) {
+	normalized, err := NormalizeNumberLocale(value, loc)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(normalized, 64)
+}
+
+// isDigits returns true for a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // FormatDistanceLocaleWithDecimals formats distance with configurable decimal places
 // Returns formatted string with appropriate unit (km, mi, or NM for nautical miles)
 func FormatDistanceLocaleWithDecimals(km float64, distUnit distance.Distance, loc locale.Locale, decimals int) string {
@@ -63,6 +136,64 @@ func ConvertDistanceToKm(value float64, distUnit distance.Distance) float64 {
 	}
 }
 
+// ConvertKmToDistance converts kilometers into the user's distance unit (km, mi, or NM)
+func ConvertKmToDistance(km float64, distUnit distance.Distance) float64 {
+	switch distUnit {
+	case distance.Miles:
+		return km * 0.621371
+	case distance.Seemiles:
+		return km * 0.539957
+	default: // distance.Kilometer
+		return km
+	}
+}
+
+// ConvertKmhToSpeed converts km/h into the speed unit of the user's distance unit (km/h, mph, or knots)
+func ConvertKmhToSpeed(kmh float64, distUnit distance.Distance) float64 {
+	return ConvertKmToDistance(kmh, distUnit)
+}
+
+// ConvertSpeedToKmh converts a speed in the user's unit (km/h, mph, or knots) to km/h
+func ConvertSpeedToKmh(value float64, distUnit distance.Distance) float64 {
+	return ConvertDistanceToKm(value, distUnit)
+}
+
+// SpeedSymbol returns the speed unit symbol for a distance unit (km/h, mph, or kn)
+func SpeedSymbol(distUnit distance.Distance) string {
+	switch distUnit {
+	case distance.Miles:
+		return "mph"
+	case distance.Seemiles:
+		return "kn"
+	default: // distance.Kilometer
+		return "km/h"
+	}
+}
+
+// ConvertBarToPressure converts bar into the user's pressure unit (bar, psi, or kPa)
+func ConvertBarToPressure(bar float64, pressUnit pressure.Pressure) float64 {
+	switch pressUnit {
+	case pressure.Psi:
+		return bar * 14.5038
+	case pressure.Kpa:
+		return bar * 100
+	default:
+		return bar
+	}
+}
+
+// ConvertPressureToBar converts a pressure in the user's unit (bar, psi, or kPa) to bar
+func ConvertPressureToBar(value float64, pressUnit pressure.Pressure) float64 {
+	switch pressUnit {
+	case pressure.Psi:
+		return value / 14.5038
+	case pressure.Kpa:
+		return value / 100
+	default:
+		return value
+	}
+}
+
 // FormatPressureLocale formats pressure according to user's pressure unit preference
 // Returns formatted string with appropriate unit (bar, psi, or kPa)
 func FormatPressureLocale(bar float64, pressUnit pressure.Pressure, loc locale.Locale) string {
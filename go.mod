@@ -5,6 +5,7 @@ go 1.25
 // update: go get -u ./...
 
 require (
+	github.com/go-pdf/fpdf v0.9.0
 	github.com/labstack/echo/v4 v4.15.0
 	github.com/xuri/excelize/v2 v2.10.0
 )
@@ -0,0 +1,136 @@
+// Package request provides a transport-agnostic view of HTTP requests for form binding,
+// table filters and dialogs, so the library works with net/http, Echo, chi or gRPC-gateway.
+package request
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+// Source is the request data read by the library: headers, query, body and form values.
+//
+// Use FromHTTP for a *http.Request (net/http, chi, gRPC-gateway) or echoadapter.Source
+// for an echo.Context.
+type Source interface {
+	// Method returns the HTTP method (e.g. "GET", "POST").
+	Method() string
+
+	// Header returns the first value of a request header ("" if missing).
+	Header(name string) string
+
+	// Query returns the URL query parameters.
+	Query() url.Values
+
+	// DecodeBody decodes the JSON request body into v.
+	// An empty body returns io.EOF.
+	DecodeBody(v any) error
+
+	// Form returns the values of a form-urlencoded body merged with the URL query.
+	Form() (url.Values, error)
+
+	// MultipartReader returns a streaming reader for a multipart/form-data body.
+	MultipartReader() (*multipart.Reader, error)
+}
+
+// httpSource is the Source of a *http.Request.
+type httpSource struct {
+	r *http.Request
+}
+
+// FromHTTP returns the Source of a *http.Request.
+//
+// Example:
+//
+//	func (ctrl *Controller) AddSave(w http.ResponseWriter, r *http.Request) {
+//	    fg, _, _ := ctrl.deviceForm(ctx).BuildAdd()
+//	    if err := builder.BindAndValidate(request.FromHTTP(r), fg); err != nil {
+//	        ...
+//	    }
+//	}
+func FromHTTP(r *http.Request) Source {
+	return &httpSource{r: r}
+}
+
+func (s *httpSource) Method() string {
+	return s.r.Method
+}
+
+func (s *httpSource) Header(name string) string {
+	return s.r.Header.Get(name)
+}
+
+func (s *httpSource) Query() url.Values {
+	return s.r.URL.Query()
+}
+
+func (s *httpSource) DecodeBody(v any) error {
+	if s.r.Body == nil {
+		return io.EOF
+	}
+	return json.NewDecoder(s.r.Body).Decode(v)
+}
+
+func (s *httpSource) Form() (url.Values, error) {
+	if err := s.r.ParseForm(); err != nil {
+		return nil, err
+	}
+	return s.r.Form, nil
+}
+
+func (s *httpSource) MultipartReader() (*multipart.Reader, error) {
+	return s.r.MultipartReader()
+}
+
+// ContentType returns the media type of the request body without parameters
+// (e.g. "application/json" for "application/json; charset=UTF-8").
+func ContentType(src Source) string {
+	mediaType, _, err := mime.ParseMediaType(src.Header("Content-Type"))
+	if err != nil {
+		return ""
+	}
+	return mediaType
+}
+
+// IsJSON returns true if the request body is JSON.
+func IsJSON(src Source) bool {
+	return ContentType(src) == "application/json"
+}
+
+// IsMultipart returns true if the request body is multipart/form-data.
+func IsMultipart(src Source) bool {
+	return ContentType(src) == "multipart/form-data"
+}
+
+// Values returns the request data as a map: the decoded JSON body, or the form values
+// (single values as string, repeated values as []string). An empty body returns an
+// empty map.
+func Values(src Source) (map[string]interface{}, error) {
+	if IsJSON(src) {
+		data := make(map[string]interface{})
+		if err := src.DecodeBody(&data); err != nil && !errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	form, err := src.Form()
+	if err != nil {
+		return nil, err
+	}
+	data := make(map[string]interface{}, len(form))
+	for key, values := range form {
+		switch len(values) {
+		case 0:
+		case 1:
+			data[key] = values[0]
+		default:
+			data[key] = values
+		}
+	}
+	return data, nil
+}
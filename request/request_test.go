@@ -0,0 +1,84 @@
+package request
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestValues_JSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name": "Truck", "ids": [1, 2]}`))
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+
+	data, err := Values(FromHTTP(req))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data["name"] != "Truck" {
+		t.Errorf("expected name 'Truck', got %v", data["name"])
+	}
+	if ids, ok := data["ids"].([]interface{}); !ok || len(ids) != 2 {
+		t.Errorf("expected 2 ids, got %v", data["ids"])
+	}
+}
+
+func TestValues_EmptyJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set("Content-Type", "application/json")
+
+	data, err := Values(FromHTTP(req))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(data) != 0 {
+		t.Errorf("expected empty map, got %v", data)
+	}
+}
+
+func TestValues_InvalidJSON(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":`))
+	req.Header.Set("Content-Type", "application/json")
+
+	if _, err := Values(FromHTTP(req)); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestValues_Form(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "/?page=2", strings.NewReader("name=Truck&ids=1&ids=2"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	data, err := Values(FromHTTP(req))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if data["name"] != "Truck" || data["page"] != "2" {
+		t.Errorf("unexpected values: %v", data)
+	}
+	if ids, ok := data["ids"].([]string); !ok || len(ids) != 2 {
+		t.Errorf("expected repeated ids as []string, got %v", data["ids"])
+	}
+}
+
+func TestContentType(t *testing.T) {
+	tests := []struct {
+		header    string
+		json      bool
+		multipart bool
+	}{
+		{"application/json", true, false},
+		{"application/json; charset=UTF-8", true, false},
+		{"multipart/form-data; boundary=xyz", false, true},
+		{"application/x-www-form-urlencoded", false, false},
+		{"", false, false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("Content-Type", tt.header)
+		src := FromHTTP(req)
+		if IsJSON(src) != tt.json || IsMultipart(src) != tt.multipart {
+			t.Errorf("%q: IsJSON=%v IsMultipart=%v", tt.header, IsJSON(src), IsMultipart(src))
+		}
+	}
+}
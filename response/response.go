@@ -211,14 +211,21 @@ const (
 	ResponseJSON  ResponseType = iota // Body is map[string]any
 	ResponseCSV                       // Body is string
 	ResponseExcel                     // Body is []byte
+	ResponsePDF                       // Body is []byte
+	ResponseTSV                       // Body is string (tab-separated CSV)
+	ResponseJSONL                     // Body is string (JSON Lines, one object per line)
+	ResponseODS                       // Body is []byte (OpenDocument spreadsheet)
+	ResponseHTML                      // Body is string (self-contained HTML document)
 )
 
 // DataResult represents a formatted response with type metadata.
 // Components return this to indicate both the data and its format,
-// without knowing about HTTP headers or framework specifics.
+// without knowing about HTTP headers or framework specifics (see Write).
 type DataResult struct {
-	Type ResponseType
-	Body any
+	Type     ResponseType
+	Body     any
+	Filename string // Download filename without extension ("" = DefaultFilename, see ExportFilename)
+	NoBOM    bool   // Write CSV/TSV as is, without adding a UTF-8 byte order mark (see Write)
 }
 
 // NewJSONDataResult wraps data in the standard {"data": ...} envelope.
@@ -231,7 +238,57 @@ func NewCSVDataResult(csv string) DataResult {
 	return DataResult{Type: ResponseCSV, Body: csv}
 }
 
+// NewTSVDataResult creates a TSV (tab-separated values) response.
+func NewTSVDataResult(tsv string) DataResult {
+	return DataResult{Type: ResponseTSV, Body: tsv}
+}
+
 // NewExcelDataResult creates an Excel response.
 func NewExcelDataResult(excel []byte) DataResult {
 	return DataResult{Type: ResponseExcel, Body: excel}
 }
+
+// NewPDFDataResult creates a PDF response.
+func NewPDFDataResult(pdf []byte) DataResult {
+	return DataResult{Type: ResponsePDF, Body: pdf}
+}
+
+// NewJSONLDataResult creates a JSON Lines (newline-delimited JSON) response.
+func NewJSONLDataResult(jsonl string) DataResult {
+	return DataResult{Type: ResponseJSONL, Body: jsonl}
+}
+
+// NewODSDataResult creates an OpenDocument spreadsheet response.
+func NewODSDataResult(ods []byte) DataResult {
+	return DataResult{Type: ResponseODS, Body: ods}
+}
+
+// NewHTMLDataResult creates an HTML document response.
+func NewHTMLDataResult(html string) DataResult {
+	return DataResult{Type: ResponseHTML, Body: html}
+}
+
+// FieldError describes the validation error of a single form field.
+type FieldError struct {
+	Field   string         `json:"field"`            // Field ID
+	Code    string         `json:"code"`             // Error code (e.g. "required", "minLength")
+	Key     string         `json:"key"`              // Translation key (e.g. "FORM_ERROR_MINLENGTH")
+	Message string         `json:"message"`          // Translated message
+	Params  map[string]any `json:"params,omitempty"` // Message parameters (e.g. {"min": 3})
+}
+
+// ValidationErrorResponse represents a form validation error response.
+// The frontend maps each entry of Errors onto the input with the matching field ID.
+//
+// JSON output: {"error": "message", "errors": [{"field": "name", "code": "required", ...}]}
+type ValidationErrorResponse struct {
+	Error  string       `json:"error"`  // Summary message (first error)
+	Errors []FieldError `json:"errors"` // One entry per failing field check
+}
+
+// NewValidationErrorResponse creates a validation error response.
+//
+// Returns: ValidationErrorResponse{"error": message, "errors": errors}
+func NewValidationErrorResponse(message string, errors []FieldError) ValidationErrorResponse {
+	return ValidationErrorResponse{Error: message, Errors: errors}
+}
@@ -0,0 +1,205 @@
+package response
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+// typeInfo describes how a ResponseType is sent over HTTP.
+type typeInfo struct {
+	contentType string
+	extension   string // File extension including the dot ("" = not a download)
+}
+
+// responseTypes maps each ResponseType to its Content-Type and file extension.
+// New download formats only need an entry here to be written by Write.
+var responseTypes = map[ResponseType]typeInfo{
+	ResponseJSON:  {"application/json; charset=UTF-8", ""},
+	ResponseCSV:   {"text/csv; charset=UTF-8", ".csv"},
+	ResponseExcel: {"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", ".xlsx"},
+	ResponsePDF:   {"application/pdf", ".pdf"},
+	ResponseTSV:   {"text/tab-separated-values; charset=UTF-8", ".tsv"},
+	ResponseJSONL: {"application/x-ndjson; charset=UTF-8", ".jsonl"},
+	ResponseODS:   {"application/vnd.oasis.opendocument.spreadsheet", ".ods"},
+	ResponseHTML:  {"text/html; charset=UTF-8", ".html"},
+}
+
+// ContentType returns the HTTP Content-Type of the response type.
+func (t ResponseType) ContentType() string {
+	if info, ok := responseTypes[t]; ok {
+		return info.contentType
+	}
+	return "application/octet-stream"
+}
+
+// Extension returns the file extension of the response type including the dot
+// ("" for JSON, which is not downloaded).
+func (t ResponseType) Extension() string {
+	return responseTypes[t].extension
+}
+
+// IsDownload returns true if the response type is sent as a file download.
+func (t ResponseType) IsDownload() bool {
+	return t.Extension() != "" || t.ContentType() == "application/octet-stream"
+}
+
+// utf8BOM marks CSV files as UTF-8 for Excel (otherwise umlauts are read as cp1252).
+const utf8BOM = "\ufeff"
+
+// DefaultFilename is the download filename used if neither the DataResult nor the
+// WriteOptions have one.
+const DefaultFilename = "export"
+
+// WriteOptions configures how Write sends a DataResult.
+type WriteOptions struct {
+	Status       int    // HTTP status code (0 = 200 OK)
+	Filename     string // Download filename without extension (overrides DataResult.Filename)
+	Inline       bool   // Show the download in the browser (Content-Disposition inline instead of attachment)
+	NoBOM        bool   // Write CSV/TSV without UTF-8 byte order mark (same as DataResult.NoBOM)
+	CacheControl string // Cache-Control header ("" = "no-store", exports contain user data)
+}
+
+// Write sends a DataResult as HTTP response: Content-Type, Content-Disposition with the
+// filename (RFC 6266 / RFC 5987), Cache-Control and the body. JSON results are encoded
+// as JSON; CSV and TSV results get a UTF-8 BOM for Excel unless the result or the options
+// set NoBOM (table exports set it if their CSVOptions have no BOM). Any other type is written as binary
+// download ([]byte or string body). For HEAD requests only the headers are written;
+// r may be nil.
+//
+// Example:
+//
+//	func (ctrl *Controller) DeviceTableData(w http.ResponseWriter, r *http.Request) {
+//	    tbl := buildDeviceTable(ctx, translator)
+//	    if _, err := tbl.LoadFilterData(request.FromHTTP(r)); err != nil {
+//	        ...
+//	    }
+//	    tbl.SetData(rows)
+//	    response.Write(w, r, tbl.DataResponse(translator), response.WriteOptions{})
+//	}
+func Write(w http.ResponseWriter, r *http.Request, result DataResult, opts WriteOptions) error {
+	var body []byte
+	switch v := result.Body.(type) {
+	case []byte:
+		body = v
+	case string:
+		body = []byte(v)
+	default:
+		if result.Type != ResponseJSON {
+			return fmt.Errorf("cannot write %T body for response type %d", result.Body, result.Type)
+		}
+	}
+	if result.Type == ResponseJSON && body == nil {
+		encoded, err := json.Marshal(result.Body)
+		if err != nil {
+			return fmt.Errorf("encoding JSON response: %w", err)
+		}
+		body = append(encoded, '\n')
+	}
+	if (result.Type == ResponseCSV || result.Type == ResponseTSV) && !opts.NoBOM && !result.NoBOM && !strings.HasPrefix(string(body), utf8BOM) {
+		body = append([]byte(utf8BOM), body...)
+	}
+
+	header := w.Header()
+	header.Set("Content-Type", result.Type.ContentType())
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	header.Set("X-Content-Type-Options", "nosniff")
+	if opts.CacheControl != "" {
+		header.Set("Cache-Control", opts.CacheControl)
+	} else {
+		header.Set("Cache-Control", "no-store")
+	}
+
+	if result.Type.IsDownload() {
+		filename := opts.Filename
+		if filename == "" {
+			filename = result.Filename
+		}
+		if filename == "" {
+			filename = DefaultFilename
+		}
+		disposition := "attachment"
+		if opts.Inline {
+			disposition = "inline"
+		}
+		header.Set("Content-Disposition", ContentDisposition(disposition, filename+result.Type.Extension()))
+	}
+
+	status := opts.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	w.WriteHeader(status)
+
+	if r != nil && r.Method == http.MethodHead {
+		return nil
+	}
+	_, err := w.Write(body)
+	return err
+}
+
+// ContentDisposition returns a Content-Disposition header value with an ASCII filename
+// fallback and the UTF-8 filename as filename* parameter (RFC 5987), e.g.
+//
+//	attachment; filename="Fahrzeuge_M_rz.csv"; filename*=UTF-8''Fahrzeuge_M%C3%A4rz.csv
+func ContentDisposition(disposition, filename string) string {
+	filename = SanitizeFilename(filename)
+	if filename == "" {
+		filename = DefaultFilename
+	}
+
+	ascii := make([]rune, 0, len(filename))
+	for _, r := range filename {
+		if r > unicode.MaxASCII {
+			r = '_'
+		}
+		ascii = append(ascii, r)
+	}
+
+	value := fmt.Sprintf("%s; filename=%q", disposition, string(ascii))
+	if string(ascii) != filename {
+		value += "; filename*=UTF-8''" + url.PathEscape(filename)
+	}
+	return value
+}
+
+// SanitizeFilename removes characters that are unsafe in filenames and headers: path
+// separators, quotes, control and other special characters become "_", whitespace
+// becomes "_". Letters of any script, digits, "-", "_" and "." are kept.
+func SanitizeFilename(name string) string {
+	var b strings.Builder
+	for _, r := range strings.TrimSpace(name) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '-', r == '_', r == '.':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	return strings.Trim(b.String(), ".")
+}
+
+// ExportFilename returns a download filename (without extension) from a title and the
+// timestamp in the user's timezone, e.g. "Vehicles_2026-10-16_1430". An empty title
+// uses DefaultFilename; ctx may be nil (UTC).
+func ExportFilename(ctx *uicontext.UiContext, title string, now time.Time) string {
+	name := SanitizeFilename(title)
+	if name == "" {
+		name = DefaultFilename
+	}
+
+	loc := time.UTC
+	if ctx != nil {
+		if l, err := time.LoadLocation(ctx.Timezone.GetIANA()); err == nil {
+			loc = l
+		}
+	}
+	return name + "_" + now.In(loc).Format("2006-01-02_1504")
+}
@@ -0,0 +1,146 @@
+package response
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/xiriframework/xiri-go/types/timezone"
+	"github.com/xiriframework/xiri-go/uicontext"
+)
+
+func TestWrite_JSON(t *testing.T) {
+	rec := httptest.NewRecorder()
+	if err := Write(rec, nil, NewJSONDataResult([]int{1, 2}), WriteOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json; charset=UTF-8" {
+		t.Errorf("unexpected Content-Type %q", ct)
+	}
+	if cd := rec.Header().Get("Content-Disposition"); cd != "" {
+		t.Errorf("expected no Content-Disposition for JSON, got %q", cd)
+	}
+	if body := strings.TrimSpace(rec.Body.String()); body != `{"data":[1,2]}` {
+		t.Errorf("unexpected body %s", body)
+	}
+}
+
+func TestWrite_CSV(t *testing.T) {
+	result := NewCSVDataResult("name;count\nTruck;4\n")
+	result.Filename = "Vehicles"
+
+	rec := httptest.NewRecorder()
+	if err := Write(rec, nil, result, WriteOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ct := rec.Header().Get("Content-Type"); ct != "text/csv; charset=UTF-8" {
+		t.Errorf("unexpected Content-Type %q", ct)
+	}
+	if cd := rec.Header().Get("Content-Disposition"); cd != `attachment; filename="Vehicles.csv"` {
+		t.Errorf("unexpected Content-Disposition %q", cd)
+	}
+	if cc := rec.Header().Get("Cache-Control"); cc != "no-store" {
+		t.Errorf("unexpected Cache-Control %q", cc)
+	}
+	if !strings.HasPrefix(rec.Body.String(), "\ufeffname;count") {
+		t.Errorf("expected BOM before CSV, got %q", rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	Write(rec, nil, result, WriteOptions{NoBOM: true})
+	if strings.HasPrefix(rec.Body.String(), "\ufeff") {
+		t.Error("expected CSV without BOM")
+	}
+
+	result.NoBOM = true
+	rec = httptest.NewRecorder()
+	Write(rec, nil, result, WriteOptions{})
+	if strings.HasPrefix(rec.Body.String(), "\ufeff") {
+		t.Error("expected CSV without BOM for DataResult.NoBOM")
+	}
+}
+
+func TestWrite_BinaryAndHead(t *testing.T) {
+	result := NewPDFDataResult([]byte("%PDF-1.4"))
+
+	req := httptest.NewRequest(http.MethodHead, "/", nil)
+	rec := httptest.NewRecorder()
+	if err := Write(rec, req, result, WriteOptions{Filename: "report", Inline: true}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cd := rec.Header().Get("Content-Disposition"); cd != `inline; filename="report.pdf"` {
+		t.Errorf("unexpected Content-Disposition %q", cd)
+	}
+	if rec.Header().Get("Content-Length") != "8" || rec.Body.Len() != 0 {
+		t.Errorf("expected headers only for HEAD, got length %s and body %q", rec.Header().Get("Content-Length"), rec.Body.String())
+	}
+
+	// Unknown future types are sent as binary download
+	rec = httptest.NewRecorder()
+	if err := Write(rec, nil, DataResult{Type: ResponseType(99), Body: []byte{1}}, WriteOptions{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/octet-stream" {
+		t.Errorf("unexpected Content-Type %q", ct)
+	}
+	if cd := rec.Header().Get("Content-Disposition"); cd != `attachment; filename="export"` {
+		t.Errorf("unexpected Content-Disposition %q", cd)
+	}
+
+	if err := Write(httptest.NewRecorder(), nil, DataResult{Type: ResponseExcel, Body: 42}, WriteOptions{}); err == nil {
+		t.Error("expected error for invalid body type")
+	}
+}
+
+func TestWrite_ExportFormats(t *testing.T) {
+	results := map[string]DataResult{
+		`application/x-ndjson; charset=UTF-8|attachment; filename="rows.jsonl"`:          NewJSONLDataResult("{}\n"),
+		`application/vnd.oasis.opendocument.spreadsheet|attachment; filename="rows.ods"`: NewODSDataResult([]byte("PK")),
+		`text/html; charset=UTF-8|attachment; filename="rows.html"`:                      NewHTMLDataResult("<html></html>"),
+	}
+	for expected, result := range results {
+		rec := httptest.NewRecorder()
+		if err := Write(rec, nil, result, WriteOptions{Filename: "rows"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := rec.Header().Get("Content-Type") + "|" + rec.Header().Get("Content-Disposition")
+		if got != expected {
+			t.Errorf("expected %q, got %q", expected, got)
+		}
+		if strings.HasPrefix(rec.Body.String(), utf8BOM) {
+			t.Errorf("expected no BOM for %q", expected)
+		}
+	}
+}
+
+func TestContentDisposition(t *testing.T) {
+	tests := []struct {
+		filename string
+		expected string
+	}{
+		{"Fahrzeuge März.csv", `attachment; filename="Fahrzeuge_M_rz.csv"; filename*=UTF-8''Fahrzeuge_M%C3%A4rz.csv`},
+		{`../../etc/"passwd"`, `attachment; filename="_.._etc__passwd_"`},
+		{"", `attachment; filename="export"`},
+	}
+	for _, tt := range tests {
+		if got := ContentDisposition("attachment", tt.filename); got != tt.expected {
+			t.Errorf("%q: expected %s, got %s", tt.filename, tt.expected, got)
+		}
+	}
+}
+
+func TestExportFilename(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 30, 0, 0, time.UTC)
+
+	ctx := &uicontext.UiContext{Timezone: timezone.EuropeVienna}
+	if got := ExportFilename(ctx, "Vehicle report", now); got != "Vehicle_report_2026-10-16_1430" {
+		t.Errorf("unexpected filename %q", got)
+	}
+	if got := ExportFilename(nil, "", now); got != "export_2026-10-16_1230" {
+		t.Errorf("unexpected filename %q", got)
+	}
+}